@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+)
+
+// command runs one invocation of wc against client.
+type command struct {
+	client *woocommerce.Client
+	in     io.Reader
+	out    io.Writer
+	format string
+	fields []string
+	all    bool
+	force  bool
+}
+
+func (c *command) run(ctx context.Context, args []string) error {
+	switch strings.ToLower(args[0]) {
+	case "get":
+		params, err := parseParams(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.print(c.client.Get(ctx, args[1], params))
+	case "post", "put":
+		if len(args) != 3 {
+			return fmt.Errorf("%s takes an endpoint and a payload", args[0])
+		}
+		body, err := c.payload(args[2])
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(args[0]) == "post" {
+			return c.print(c.client.Post(ctx, args[1], body))
+		}
+		return c.print(c.client.Put(ctx, args[1], body))
+	case "delete":
+		params, err := parseParams(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.print(c.client.Delete(ctx, args[1], c.force, params))
+	case "options":
+		return c.print(c.client.Options(ctx, args[1]))
+	}
+	return c.resource(ctx, args[0], args[1], args[2:])
+}
+
+// resource runs verb on the collection at route.
+func (c *command) resource(ctx context.Context, route, verb string, args []string) error {
+	need := func(n int, what string) error {
+		if len(args) != n {
+			return fmt.Errorf("%s %s takes %s", route, verb, what)
+		}
+		return nil
+	}
+	switch verb {
+	case "list":
+		params, err := parseParams(args)
+		if err != nil {
+			return err
+		}
+		if !c.all {
+			return c.print(c.client.Get(ctx, route, params))
+		}
+		var items []json.RawMessage
+		err = c.client.ListAll(ctx, route, params, func(page []json.RawMessage) error {
+			items = append(items, page...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		return c.write(data)
+	case "get":
+		if err := need(1, "an ID"); err != nil {
+			return err
+		}
+		path, err := itemPath(route, args[0])
+		if err != nil {
+			return err
+		}
+		return c.print(c.client.Get(ctx, path, nil))
+	case "create", "batch":
+		if err := need(1, "a payload"); err != nil {
+			return err
+		}
+		body, err := c.payload(args[0])
+		if err != nil {
+			return err
+		}
+		if verb == "batch" {
+			route += "/batch"
+		}
+		return c.print(c.client.Post(ctx, route, body))
+	case "update":
+		if err := need(2, "an ID and a payload"); err != nil {
+			return err
+		}
+		path, err := itemPath(route, args[0])
+		if err != nil {
+			return err
+		}
+		body, err := c.payload(args[1])
+		if err != nil {
+			return err
+		}
+		return c.print(c.client.Put(ctx, path, body))
+	case "delete":
+		if err := need(1, "an ID"); err != nil {
+			return err
+		}
+		path, err := itemPath(route, args[0])
+		if err != nil {
+			return err
+		}
+		return c.print(c.client.Delete(ctx, path, c.force, nil))
+	}
+	return fmt.Errorf("unknown verb %q, want list, get, create, update, delete or batch", verb)
+}
+
+// itemPath returns the path of the item id in the collection at route,
+// rejecting IDs that are not positive integers so they cannot change the
+// path, e.g. "../settings" or "1?force=true".
+func itemPath(route, id string) (string, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil || n < 1 {
+		return "", fmt.Errorf("invalid ID %q, want a positive integer", id)
+	}
+	return route + "/" + strconv.Itoa(n), nil
+}
+
+// payload returns the JSON body given as arg: inline, @file or - for
+// standard input.
+func (c *command) payload(arg string) (io.Reader, error) {
+	var data []byte
+	var err error
+	switch {
+	case arg == "-":
+		data, err = io.ReadAll(c.in)
+	case strings.HasPrefix(arg, "@"):
+		data, err = os.ReadFile(arg[1:])
+	default:
+		data = []byte(arg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("payload is not valid JSON")
+	}
+	return bytes.NewReader(data), nil
+}
+
+func (c *command) print(resp *woocommerce.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	data, err := io.ReadAll(resp)
+	if err != nil {
+		return err
+	}
+	return c.write(data)
+}
+
+func (c *command) write(data []byte) error {
+	if c.format == "table" {
+		return writeTable(c.out, data, c.fields)
+	}
+	if c.format != "json" {
+		return fmt.Errorf("unknown output format %q", c.format)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(c.out)
+	return err
+}
+
+// parseParams turns key=value arguments into query parameters.
+func parseParams(args []string) (url.Values, error) {
+	params := url.Values{}
+	for _, arg := range args {
+		k, v, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("parameter %q is not key=value", arg)
+		}
+		params.Add(k, v)
+	}
+	return params, nil
+}
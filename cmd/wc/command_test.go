@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+)
+
+func TestCommand(t *testing.T) {
+	var method, path, query, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query = r.Method, r.URL.Path, r.URL.Query().Encode()
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		if r.Method == http.MethodGet && strings.HasSuffix(path, "/products") {
+			w.Write([]byte(`[{"id":794,"name":"Premium Quality","sku":"PQ-1","status":"publish","images":[]},{"id":795,"name":"Ship Your Idea","sku":"","status":"draft"}]`))
+			return
+		}
+		w.Write([]byte(`{"id":123,"status":"processing"}`))
+	}))
+	defer srv.Close()
+	client, err := woocommerce.NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, tc := range []struct {
+		args   []string
+		force  bool
+		method string
+		path   string
+	}{
+		{[]string{"orders", "get", "123"}, false, "GET", "/wp-json/wc/v3/orders/123"},
+		{[]string{"post", "products", `{"name":"Mug"}`}, false, "POST", "/wp-json/wc/v3/products"},
+		{[]string{"orders", "update", "123", "-"}, false, "PUT", "/wp-json/wc/v3/orders/123"},
+		{[]string{"coupons", "delete", "719"}, true, "DELETE", "/wp-json/wc/v3/coupons/719"},
+		{[]string{"products", "batch", `{"delete":[1]}`}, false, "POST", "/wp-json/wc/v3/products/batch"},
+	} {
+		var out bytes.Buffer
+		cmd := &command{client: client, in: strings.NewReader(`{"status":"completed"}`), out: &out, format: "json", force: tc.force}
+		if err := cmd.run(ctx, tc.args); err != nil {
+			t.Fatalf("%v: %v", tc.args, err)
+		}
+		if method != tc.method || path != tc.path {
+			t.Errorf("%v sent %s %s", tc.args, method, path)
+		}
+		if !strings.Contains(out.String(), `"id": 123`) {
+			t.Errorf("%v printed %s", tc.args, out.String())
+		}
+	}
+	if body != `{"delete":[1]}` {
+		t.Errorf("batch body = %s", body)
+	}
+
+	var out bytes.Buffer
+	cmd := &command{client: client, out: &out, format: "table"}
+	if err := cmd.run(ctx, []string{"products", "list", "status=any"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "status=any") {
+		t.Errorf("query = %s", query)
+	}
+	want := "ID   NAME             SKU   STATUS\n794  Premium Quality  PQ-1  publish\n795  Ship Your Idea         draft\n"
+	if out.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	if err := cmd.run(ctx, []string{"products", "update", "794"}); err == nil {
+		t.Error("expected error for a missing payload")
+	}
+	if err := cmd.run(ctx, []string{"products", "create", "{"}); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+	for _, id := range []string{"../settings", "1?force=true", "0", ""} {
+		if err := cmd.run(ctx, []string{"products", "get", id}); err == nil || !strings.Contains(err.Error(), "invalid ID") {
+			t.Errorf("get %q: err = %v, want an invalid ID error", id, err)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("WC_CONSUMER_SECRET", "cs_env")
+	secret := ""
+	fromEnv(&secret, "WC_CONSUMER_SECRET")
+	if secret != "cs_env" {
+		t.Errorf("unset flag = %q, want the environment", secret)
+	}
+	secret = "cs_flag"
+	fromEnv(&secret, "WC_CONSUMER_SECRET")
+	if secret != "cs_flag" {
+		t.Errorf("set flag = %q, want the flag", secret)
+	}
+}
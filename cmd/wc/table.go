@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// defaultColumns are shown, when present, if no fields are requested.
+var defaultColumns = []string{"id", "number", "name", "sku", "email", "code", "status", "price", "total", "date_created"}
+
+// writeTable prints a JSON object or array of objects as aligned columns.
+// Nested values are printed as compact JSON.
+func writeTable(w io.Writer, data []byte, fields []string) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	var rows []map[string]interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		for _, item := range v {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("table output needs objects, got %T", item)
+			}
+			rows = append(rows, row)
+		}
+	case map[string]interface{}:
+		rows = append(rows, v)
+	default:
+		return fmt.Errorf("table output needs objects, got %T", v)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	if len(fields) == 0 {
+		fields = columns(rows[0])
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(fields, "\t")))
+	for _, row := range rows {
+		cells := make([]string, len(fields))
+		for i, f := range fields {
+			cells[i] = cell(row[f])
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// columns picks the default columns present in row, or all of its scalar
+// fields if none are.
+func columns(row map[string]interface{}) []string {
+	var cols []string
+	for _, c := range defaultColumns {
+		if _, ok := row[c]; ok {
+			cols = append(cols, c)
+		}
+	}
+	if len(cols) > 0 {
+		return cols
+	}
+	for k, v := range row {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+		default:
+			cols = append(cols, k)
+		}
+	}
+	sort.Strings(cols)
+	return cols
+}
+
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
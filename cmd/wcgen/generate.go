@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// Config describes the code to generate for one route.
+type Config struct {
+	Package string
+	// Route is the endpoint relative to the API root, e.g. "products" or
+	// "bookings/resources".
+	Route string
+	// Type names the generated model; nested objects are named after it.
+	Type string
+	// Service also generates a service with CRUD methods for the route.
+	Service bool
+}
+
+type generator struct {
+	cfg     Config
+	qual    string
+	structs bytes.Buffer
+	seen    map[string]bool
+}
+
+// Generate returns gofmt'ed Go source for the schema.
+func Generate(cfg Config, schema *Schema) ([]byte, error) {
+	g := &generator{cfg: cfg, seen: make(map[string]bool)}
+	if cfg.Package != "woocommerce" {
+		g.qual = "woocommerce."
+	}
+	g.emitStruct(cfg.Type, schema.Properties)
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by wcgen from the %s schema. DO NOT EDIT.\n\n", cfg.Route)
+	fmt.Fprintf(&src, "package %s\n\n", cfg.Package)
+	if cfg.Service {
+		src.WriteString("import (\n\t\"bytes\"\n\t\"context\"\n\t\"encoding/json\"\n\t\"strconv\"\n")
+		if g.qual != "" {
+			src.WriteString("\n\t\"github.com/mikespook/wc-api-golang/woocommerce\"\n")
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(g.structs.Bytes())
+	if cfg.Service {
+		g.emitService(&src)
+	}
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Generated code does not compile: %v\n%s", err, src.Bytes())
+	}
+	return out, nil
+}
+
+func (g *generator) emitStruct(name string, props Properties) {
+	if g.seen[name] {
+		return
+	}
+	g.seen[name] = true
+	var body bytes.Buffer
+	var nested []func()
+	for _, key := range props.Names {
+		prop := props.ByName[key]
+		field := goName(key)
+		typ := g.goType(name+field, prop, &nested)
+		if typ == "bool" && !prop.ReadOnly {
+			// A writable flag is a pointer so that false is sent.
+			typ = "*bool"
+		}
+		fmt.Fprintf(&body, "\t%s %s `json:\"%s,omitempty\"`\n", field, typ, key)
+	}
+	fmt.Fprintf(&g.structs, "type %s struct {\n%s}\n\n", name, body.Bytes())
+	for _, emit := range nested {
+		emit()
+	}
+}
+
+// goType maps a property to a Go type, queueing structs for nested
+// objects on nested. name is used for such structs.
+func (g *generator) goType(name string, prop *Property, nested *[]func()) string {
+	if prop == nil {
+		return "interface{}"
+	}
+	typ, nullable := "", false
+	for _, t := range prop.Type {
+		if t == "null" {
+			nullable = true
+		} else if typ == "" {
+			typ = t
+		}
+	}
+	switch typ {
+	case "string":
+		return "string"
+	case "integer":
+		if nullable {
+			return "*int"
+		}
+		return "int"
+	case "number":
+		if nullable {
+			return "*float64"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + g.goType(singular(name), prop.Items, nested)
+	case "object":
+		if len(prop.Properties.Names) == 0 {
+			return "map[string]interface{}"
+		}
+		props := prop.Properties
+		*nested = append(*nested, func() { g.emitStruct(name, props) })
+		return name
+	}
+	return "interface{}"
+}
+
+func (g *generator) emitService(src *bytes.Buffer) {
+	t, q, route := g.cfg.Type, g.qual, g.cfg.Route
+	svc := t + "Service"
+	fmt.Fprintf(src, `// %[1]s accesses /%[3]s.
+type %[1]s struct {
+	client *%[4]sClient
+}
+
+func New%[1]s(client *%[4]sClient) *%[1]s {
+	return &%[1]s{client: client}
+}
+
+func (s *%[1]s) List(ctx context.Context, params *%[4]sListParams) ([]%[2]s, *%[4]sResponse, error) {
+	query, err := params.Values()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.Get(ctx, %[3]q, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Close()
+	var items []%[2]s
+	err = json.NewDecoder(resp).Decode(&items)
+	return items, resp, err
+}
+
+func (s *%[1]s) Get(ctx context.Context, id int) (*%[2]s, error) {
+	resp, err := s.client.Get(ctx, %[3]q+"/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	item := new(%[2]s)
+	return item, json.NewDecoder(resp).Decode(item)
+}
+
+func (s *%[1]s) Create(ctx context.Context, item *%[2]s) (*%[2]s, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Post(ctx, %[3]q, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	created := new(%[2]s)
+	return created, json.NewDecoder(resp).Decode(created)
+}
+
+func (s *%[1]s) Update(ctx context.Context, id int, item *%[2]s) (*%[2]s, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Put(ctx, %[3]q+"/"+strconv.Itoa(id), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	updated := new(%[2]s)
+	return updated, json.NewDecoder(resp).Decode(updated)
+}
+
+func (s *%[1]s) Delete(ctx context.Context, id int, force bool) (*%[2]s, error) {
+	resp, err := s.client.Delete(ctx, %[3]q+"/"+strconv.Itoa(id), force, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	deleted := new(%[2]s)
+	return deleted, json.NewDecoder(resp).Decode(deleted)
+}
+`, svc, t, route, q)
+}
+
+var initialisms = map[string]string{
+	"id": "ID", "ids": "IDs", "url": "URL", "uri": "URI", "sku": "SKU",
+	"gmt": "GMT", "html": "HTML", "api": "API", "ip": "IP", "json": "JSON",
+}
+
+// goName converts a snake_case property name to an exported Go name.
+func goName(key string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(key, func(r rune) bool { return r == '_' || r == '-' }) {
+		if s, ok := initialisms[part]; ok {
+			b.WriteString(s)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	if b.Len() == 0 || !isLetter(b.String()[0]) {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
+
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "ss"):
+		return name
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
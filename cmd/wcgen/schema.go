@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Schema is the JSON Schema returned in the "schema" member of an OPTIONS
+// response.
+type Schema struct {
+	Title      string     `json:"title"`
+	Type       string     `json:"type"`
+	Properties Properties `json:"properties"`
+}
+
+type Property struct {
+	Type        Types      `json:"type"`
+	Description string     `json:"description"`
+	Format      string     `json:"format"`
+	ReadOnly    bool       `json:"readonly"`
+	Items       *Property  `json:"items"`
+	Properties  Properties `json:"properties"`
+}
+
+// Types is a schema type, which may be a single type or a list such as
+// ["integer", "null"].
+type Types []string
+
+func (t *Types) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var list []string
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*t = list
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*t = Types{s}
+	return nil
+}
+
+// Properties keeps the properties of an object in schema order, so that
+// generated fields follow the order WooCommerce documents them in.
+type Properties struct {
+	Names  []string
+	ByName map[string]*Property
+}
+
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	// PHP encodes an empty properties object as [].
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	p.ByName = make(map[string]*Property)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("Unexpected token in properties: %v", tok)
+		}
+		prop := new(Property)
+		if err := dec.Decode(prop); err != nil {
+			return fmt.Errorf("Property %s: %v", name, err)
+		}
+		if _, dup := p.ByName[name]; !dup {
+			p.Names = append(p.Names, name)
+		}
+		p.ByName[name] = prop
+	}
+	return nil
+}
+
+// ParseSchema reads a schema from either a full OPTIONS response or the
+// bare schema object.
+func ParseSchema(data []byte) (*Schema, error) {
+	var options struct {
+		Schema *Schema `json:"schema"`
+	}
+	if err := json.Unmarshal(data, &options); err == nil && options.Schema != nil {
+		return options.Schema, nil
+	}
+	schema := new(Schema)
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
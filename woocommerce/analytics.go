@@ -0,0 +1,160 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AnalyticsService reads the WooCommerce Analytics reports at
+// /wp-json/wc-analytics, which WooCommerce 4.0 and later use for the
+// admin dashboard. Amounts are in the store currency.
+type AnalyticsService service
+
+// AnalyticsParams selects the range and grouping of an analytics report.
+// The zero value reports on the last week by day.
+type AnalyticsParams struct {
+	// Interval groups the report: "hour", "day", "week", "month",
+	// "quarter" or "year".
+	Interval string
+	After    time.Time
+	Before   time.Time
+	// PerPage and Page page through the intervals. PerPage defaults to
+	// 100, the most WooCommerce allows.
+	PerPage int
+	Page    int
+}
+
+// Analytics intervals.
+const (
+	AnalyticsIntervalHour    = "hour"
+	AnalyticsIntervalDay     = "day"
+	AnalyticsIntervalWeek    = "week"
+	AnalyticsIntervalMonth   = "month"
+	AnalyticsIntervalQuarter = "quarter"
+	AnalyticsIntervalYear    = "year"
+)
+
+func (p *AnalyticsParams) values() url.Values {
+	query := url.Values{"per_page": {"100"}}
+	if p == nil {
+		return query
+	}
+	if p.Interval != "" {
+		query.Set("interval", p.Interval)
+	}
+	if !p.After.IsZero() {
+		query.Set("after", p.After.Format("2006-01-02T15:04:05"))
+	}
+	if !p.Before.IsZero() {
+		query.Set("before", p.Before.Format("2006-01-02T15:04:05"))
+	}
+	if p.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		query.Set("page", strconv.Itoa(p.Page))
+	}
+	return query
+}
+
+// AnalyticsStats is a stats report: totals over the whole range and the
+// same figures for each interval.
+type AnalyticsStats[T any] struct {
+	Totals    T                      `json:"totals"`
+	Intervals []AnalyticsInterval[T] `json:"intervals"`
+}
+
+type AnalyticsInterval[T any] struct {
+	Interval     string `json:"interval"`
+	DateStart    WCTime `json:"date_start"`
+	DateStartGMT WCTime `json:"date_start_gmt"`
+	DateEnd      WCTime `json:"date_end"`
+	DateEndGMT   WCTime `json:"date_end_gmt"`
+	Subtotals    T      `json:"subtotals"`
+}
+
+type RevenueTotals struct {
+	OrdersCount  int     `json:"orders_count"`
+	NumItemsSold int     `json:"num_items_sold"`
+	GrossSales   float64 `json:"gross_sales"`
+	TotalSales   float64 `json:"total_sales"`
+	Coupons      float64 `json:"coupons"`
+	CouponsCount int     `json:"coupons_count"`
+	Refunds      float64 `json:"refunds"`
+	Taxes        float64 `json:"taxes"`
+	Shipping     float64 `json:"shipping"`
+	NetRevenue   float64 `json:"net_revenue"`
+	Products     int     `json:"products"`
+}
+
+type OrdersTotals struct {
+	OrdersCount           int     `json:"orders_count"`
+	NumItemsSold          int     `json:"num_items_sold"`
+	AvgItemsPerOrder      float64 `json:"avg_items_per_order"`
+	AvgOrderValue         float64 `json:"avg_order_value"`
+	NetRevenue            float64 `json:"net_revenue"`
+	Coupons               float64 `json:"coupons"`
+	CouponsCount          int     `json:"coupons_count"`
+	NumNewCustomers       int     `json:"num_new_customers"`
+	NumReturningCustomers int     `json:"num_returning_customers"`
+	Products              int     `json:"products"`
+}
+
+type ProductsTotals struct {
+	ItemsSold       int     `json:"items_sold"`
+	NetRevenue      float64 `json:"net_revenue"`
+	OrdersCount     int     `json:"orders_count"`
+	ProductsCount   int     `json:"products_count"`
+	VariationsCount int     `json:"variations_count"`
+}
+
+// Leaderboard is a ranking such as top customers or top products. Each
+// row holds one cell per header.
+type Leaderboard struct {
+	ID      string              `json:"id"`
+	Label   string              `json:"label"`
+	Headers []LeaderboardHeader `json:"headers"`
+	Rows    [][]LeaderboardCell `json:"rows"`
+}
+
+type LeaderboardHeader struct {
+	Label string `json:"label"`
+}
+
+// LeaderboardCell holds a formatted value for display and, for most
+// columns, the raw value.
+type LeaderboardCell struct {
+	Display string      `json:"display"`
+	Value   interface{} `json:"value"`
+}
+
+func (s *AnalyticsService) RevenueStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[RevenueTotals], error) {
+	stats := new(AnalyticsStats[RevenueTotals])
+	_, err := s.client.call(ctx, http.MethodGet, "/wc-analytics/reports/revenue/stats", params.values(), nil, stats)
+	return stats, err
+}
+
+func (s *AnalyticsService) OrdersStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[OrdersTotals], error) {
+	stats := new(AnalyticsStats[OrdersTotals])
+	_, err := s.client.call(ctx, http.MethodGet, "/wc-analytics/reports/orders/stats", params.values(), nil, stats)
+	return stats, err
+}
+
+func (s *AnalyticsService) ProductsStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[ProductsTotals], error) {
+	stats := new(AnalyticsStats[ProductsTotals])
+	_, err := s.client.call(ctx, http.MethodGet, "/wc-analytics/reports/products/stats", params.values(), nil, stats)
+	return stats, err
+}
+
+// Leaderboards returns the dashboard leaderboards for the range, with up
+// to PerPage rows each.
+func (s *AnalyticsService) Leaderboards(ctx context.Context, params *AnalyticsParams) ([]Leaderboard, error) {
+	var boards []Leaderboard
+	query := params.values()
+	query.Del("interval")
+	_, err := s.client.call(ctx, http.MethodGet, "/wc-analytics/leaderboards", query, nil, &boards)
+	return boards, err
+}
@@ -0,0 +1,52 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAnalyticsRevenueStats(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc-analytics/reports/revenue/stats" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("interval") != "month" || q.Get("after") != "2024-01-01T00:00:00" || q.Get("per_page") != "100" {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"totals":{"orders_count":12,"num_items_sold":30,"gross_sales":1250.5,"total_sales":1200,"coupons":10,"coupons_count":1,"refunds":40.5,"taxes":0,"shipping":100,"net_revenue":1100,"products":8,"segments":[]},
+			"intervals":[{"interval":"2024-01","date_start":"2024-01-01 00:00:00","date_start_gmt":"2024-01-01 00:00:00","date_end":"2024-01-31 23:59:59","date_end_gmt":"2024-01-31 23:59:59","subtotals":{"orders_count":12,"net_revenue":1100}}]}`))
+	})
+	stats, err := client.Analytics.RevenueStats(context.Background(), &AnalyticsParams{
+		Interval: AnalyticsIntervalMonth,
+		After:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Totals.OrdersCount != 12 || stats.Totals.GrossSales != 1250.5 || stats.Totals.Refunds != 40.5 {
+		t.Errorf("totals = %+v", stats.Totals)
+	}
+	if len(stats.Intervals) != 1 || stats.Intervals[0].Interval != "2024-01" || stats.Intervals[0].Subtotals.NetRevenue != 1100 {
+		t.Errorf("intervals = %+v", stats.Intervals)
+	}
+}
+
+func TestAnalyticsLeaderboards(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc-analytics/leaderboards" || r.URL.Query().Has("interval") {
+			t.Errorf("sent %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`[{"id":"customers","label":"Top customers - Total spend","headers":[{"label":"Customer name"},{"label":"Orders"},{"label":"Total spend"}],
+			"rows":[[{"display":"John Doe","value":"John Doe"},{"display":"3","value":3},{"display":"$120.00","value":120}]]}]`))
+	})
+	boards, err := client.Analytics.Leaderboards(context.Background(), &AnalyticsParams{Interval: AnalyticsIntervalDay, PerPage: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boards) != 1 || boards[0].ID != "customers" || len(boards[0].Headers) != 3 || boards[0].Rows[0][2].Display != "$120.00" {
+		t.Errorf("boards = %+v", boards)
+	}
+}
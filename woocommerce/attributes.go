@@ -0,0 +1,109 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// ProductAttributesService manages the global attributes at
+// /products/attributes.
+type ProductAttributesService service
+
+// AttributeTermsService manages /products/attributes/{id}/terms.
+type AttributeTermsService service
+
+// Attribute is a global product attribute, such as Color, whose terms
+// can be assigned to products and used for variations.
+type Attribute struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Type        string `json:"type,omitempty"`
+	OrderBy     string `json:"order_by,omitempty"`
+	HasArchives *bool  `json:"has_archives,omitempty"`
+}
+
+type AttributeTerm struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	MenuOrder   int    `json:"menu_order,omitempty"`
+	Count       int    `json:"count,omitempty"`
+}
+
+func (s *ProductAttributesService) List(ctx context.Context) ([]Attribute, error) {
+	var attributes []Attribute
+	_, err := s.client.list(ctx, "products/attributes", nil, &attributes)
+	return attributes, err
+}
+
+func (s *ProductAttributesService) Get(ctx context.Context, id int) (*Attribute, error) {
+	attribute := new(Attribute)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "attributes", id), nil, nil, attribute)
+	return attribute, err
+}
+
+func (s *ProductAttributesService) Create(ctx context.Context, attribute *Attribute) (*Attribute, error) {
+	created := new(Attribute)
+	_, err := s.client.call(ctx, http.MethodPost, "products/attributes", nil, attribute, created)
+	return created, err
+}
+
+func (s *ProductAttributesService) Update(ctx context.Context, id int, attribute *Attribute) (*Attribute, error) {
+	updated := new(Attribute)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "attributes", id), nil, attribute, updated)
+	return updated, err
+}
+
+// Delete deletes an attribute and all of its terms.
+func (s *ProductAttributesService) Delete(ctx context.Context, id int) (*Attribute, error) {
+	deleted := new(Attribute)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "attributes", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductAttributesService) Batch(ctx context.Context, req *BatchRequest[Attribute]) (*BatchResponse[Attribute], error) {
+	return batch(ctx, s.client, "products/attributes/batch", req)
+}
+
+func (s *AttributeTermsService) List(ctx context.Context, attributeID int, params *ListParams) ([]AttributeTerm, *Response, error) {
+	var terms []AttributeTerm
+	resp, err := s.client.list(ctx, buildPath("products", "attributes", attributeID, "terms"), params, &terms)
+	return terms, resp, err
+}
+
+// ListAll calls fn with every page of the attribute's terms.
+func (s *AttributeTermsService) ListAll(ctx context.Context, attributeID int, params *ListParams, fn func([]AttributeTerm) error) error {
+	return listAll(ctx, s.client, buildPath("products", "attributes", attributeID, "terms"), params, fn)
+}
+
+func (s *AttributeTermsService) Get(ctx context.Context, attributeID, id int) (*AttributeTerm, error) {
+	term := new(AttributeTerm)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "attributes", attributeID, "terms", id), nil, nil, term)
+	return term, err
+}
+
+func (s *AttributeTermsService) Create(ctx context.Context, attributeID int, term *AttributeTerm) (*AttributeTerm, error) {
+	created := new(AttributeTerm)
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("products", "attributes", attributeID, "terms"), nil, term, created)
+	return created, err
+}
+
+func (s *AttributeTermsService) Update(ctx context.Context, attributeID, id int, term *AttributeTerm) (*AttributeTerm, error) {
+	updated := new(AttributeTerm)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "attributes", attributeID, "terms", id), nil, term, updated)
+	return updated, err
+}
+
+// Delete deletes a term. Terms cannot be trashed, so the request is always
+// forced.
+func (s *AttributeTermsService) Delete(ctx context.Context, attributeID, id int) (*AttributeTerm, error) {
+	deleted := new(AttributeTerm)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "attributes", attributeID, "terms", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *AttributeTermsService) Batch(ctx context.Context, attributeID int, req *BatchRequest[AttributeTerm]) (*BatchResponse[AttributeTerm], error) {
+	return batch(ctx, s.client, buildPath("products", "attributes", attributeID, "terms", "batch"), req)
+}
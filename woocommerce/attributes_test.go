@@ -0,0 +1,77 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestProductAttributes(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		if r.Method == http.MethodGet && r.URL.Path == "/wp-json/wc/v3/products/attributes" {
+			w.Write([]byte(`[{"id":1,"name":"Color","slug":"pa_color","type":"select","order_by":"menu_order","has_archives":true}]`))
+			return
+		}
+		w.Write([]byte(`{"id":1,"name":"Color","slug":"pa_color","type":"select","order_by":"menu_order","has_archives":true}`))
+	})
+	ctx := context.Background()
+	attributes, err := client.ProductAttributes.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attributes) != 1 || attributes[0].Slug != "pa_color" || !isTrue(attributes[0].HasArchives) {
+		t.Errorf("attributes = %+v", attributes)
+	}
+	if _, err := client.ProductAttributes.Create(ctx, &Attribute{Name: "Color", Type: "select", OrderBy: "menu_order"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["order_by"] != "menu_order" {
+		t.Errorf("create sent %s %v", method, body)
+	}
+	if _, err := client.ProductAttributes.Delete(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || path != "/wp-json/wc/v3/products/attributes/1" {
+		t.Errorf("delete sent %s %s", method, path)
+	}
+}
+
+func TestAttributeTerms(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet && r.URL.Path == "/wp-json/wc/v3/products/attributes/1/terms" {
+			w.Write([]byte(`[{"id":23,"name":"XXS","slug":"xxs","menu_order":1,"count":1}]`))
+			return
+		}
+		w.Write([]byte(`{"id":23,"name":"XXS","slug":"xxs","menu_order":1,"count":1}`))
+	})
+	ctx := context.Background()
+	terms, _, err := client.AttributeTerms.List(ctx, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terms) != 1 || terms[0].Slug != "xxs" {
+		t.Errorf("terms = %+v", terms)
+	}
+	if _, err := client.AttributeTerms.Create(ctx, 1, &AttributeTerm{Name: "XXS"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/products/attributes/1/terms" {
+		t.Errorf("create sent %s %s", method, path)
+	}
+	if _, err := client.AttributeTerms.Update(ctx, 1, 23, &AttributeTerm{MenuOrder: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/products/attributes/1/terms/23" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+}
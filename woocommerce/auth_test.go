@@ -0,0 +1,151 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testJWT(n int, exp time.Time) string {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d,"n":%d}`, exp.Unix(), n)))
+	return "e30." + claims + ".sig"
+}
+
+func TestApplicationPasswordAuth(t *testing.T) {
+	var user, pass string
+	srv := newTestServer(t, true, func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ = r.BasicAuth()
+		w.Write([]byte("[]"))
+	})
+	client, err := NewClient(srv.URL, "", "", &Option{
+		Auth: &ApplicationPasswordAuth{Username: "admin", Password: "abcd EFGH 1234 ijkl MNOP 6789"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Orders.List(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if user != "admin" || pass != "abcd EFGH 1234 ijkl MNOP 6789" {
+		t.Errorf("basic auth = %q, %q", user, pass)
+	}
+}
+
+func TestJWTAuth(t *testing.T) {
+	var issued int
+	valid := ""
+	srv := newTestServer(t, true, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wp-json/jwt-auth/v1/token" {
+			var creds map[string]string
+			json.NewDecoder(r.Body).Decode(&creds)
+			if creds["username"] != "admin" || creds["password"] != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"code":"[jwt_auth] incorrect_password","message":"Wrong password","data":{"status":403}}`))
+				return
+			}
+			issued++
+			valid = testJWT(issued, time.Now().Add(time.Hour))
+			json.NewEncoder(w).Encode(map[string]string{"token": valid})
+			return
+		}
+		if r.URL.Query().Get("consumer_key") != "" {
+			t.Error("consumer key sent with JWT auth")
+		}
+		if r.Header.Get("Authorization") != "Bearer "+valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":"woocommerce_rest_cannot_view","message":"Sorry","data":{"status":401}}`))
+			return
+		}
+		w.Write([]byte("[]"))
+	})
+	auth := NewJWTAuth(srv.URL, "admin", "secret")
+	auth.HTTPClient = srv.Client()
+	client, err := NewClient(srv.URL, "", "", &Option{Auth: auth})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Orders.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if issued != 1 {
+		t.Errorf("issued %d tokens, want 1", issued)
+	}
+
+	// A revoked token is refreshed once.
+	valid = "revoked"
+	if _, _, err := client.Orders.List(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if issued != 2 {
+		t.Errorf("issued %d tokens, want 2", issued)
+	}
+
+	auth = NewJWTAuth(srv.URL, "admin", "wrong")
+	auth.HTTPClient = srv.Client()
+	_, err = auth.Token(ctx)
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Unix(1700000000, 0)
+	if got := jwtExpiry(testJWT(1, exp)); !got.Equal(exp) {
+		t.Errorf("expiry = %v, want %v", got, exp)
+	}
+	if got := jwtExpiry("opaque"); !got.IsZero() {
+		t.Errorf("expiry = %v, want zero", got)
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	for _, tc := range []struct {
+		key         string
+		read, write bool
+	}{
+		{"read", true, false},
+		{"write", false, true},
+		{"read_write", true, true},
+	} {
+		t.Run(tc.key, func(t *testing.T) {
+			var batch string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					data, _ := io.ReadAll(r.Body)
+					batch = string(data)
+				}
+				if (r.Method == http.MethodGet && tc.key == "write") || (r.Method != http.MethodGet && tc.key == "read") {
+					w.WriteHeader(http.StatusUnauthorized)
+					w.Write([]byte(`{"code":"woocommerce_rest_authentication_error","message":"The API key provided does not have the required permissions.","data":{"status":401}}`))
+					return
+				}
+				w.Write([]byte(`[]`))
+			})
+			read, write, err := client.CheckPermissions(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if read != tc.read || write != tc.write {
+				t.Errorf("permissions = %v, %v, want %v, %v", read, write, tc.read, tc.write)
+			}
+			if batch != "{}" {
+				t.Errorf("write probe body = %q", batch)
+			}
+		})
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, _, err := client.CheckPermissions(context.Background()); err == nil {
+		t.Error("expected error from a failing store")
+	}
+}
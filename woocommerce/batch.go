@@ -0,0 +1,167 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// maxBatchSize is the number of items WooCommerce accepts in one batch
+// request, counting creates, updates and deletes together.
+const maxBatchSize = 100
+
+// BatchRequest groups creates, updates and deletes for a batch endpoint.
+// Items to update must have their ID set; deleted items are removed
+// permanently rather than trashed. Requests with more than 100 items are
+// split and sent as several batches.
+type BatchRequest[T any] struct {
+	Create []T   `json:"create,omitempty"`
+	Update []T   `json:"update,omitempty"`
+	Delete []int `json:"delete,omitempty"`
+}
+
+// BatchResponse holds the resulting resources in request order. Items that
+// failed are left at their zero value and reported in Errors.
+type BatchResponse[T any] struct {
+	Create []T           `json:"create,omitempty"`
+	Update []T           `json:"update,omitempty"`
+	Delete []T           `json:"delete,omitempty"`
+	Errors []*BatchError `json:"-"`
+}
+
+// BatchError reports a failed item of a batch. Op is "create", "update" or
+// "delete" and Index is the item's position in the matching slice of the
+// BatchRequest.
+type BatchError struct {
+	Op    string
+	Index int
+	Err   *APIError
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("Batch %s %d failed: %s (%s)", e.Op, e.Index, e.Err.Message, e.Err.Code)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// Err returns the first item error, or nil if every item succeeded.
+func (r *BatchResponse[T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return r.Errors[0]
+}
+
+func (r *BatchResponse[T]) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Create []json.RawMessage `json:"create"`
+		Update []json.RawMessage `json:"update"`
+		Delete []json.RawMessage `json:"delete"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var err error
+	r.Errors = nil
+	if r.Create, err = r.decodeItems("create", raw.Create); err != nil {
+		return err
+	}
+	if r.Update, err = r.decodeItems("update", raw.Update); err != nil {
+		return err
+	}
+	r.Delete, err = r.decodeItems("delete", raw.Delete)
+	return err
+}
+
+func (r *BatchResponse[T]) decodeItems(op string, raw []json.RawMessage) ([]T, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	items := make([]T, len(raw))
+	for i, data := range raw {
+		var probe struct {
+			Error *APIError `json:"error"`
+		}
+		if err := json.Unmarshal(data, &probe); err != nil {
+			return nil, err
+		}
+		if probe.Error != nil {
+			probe.Error.StatusCode = probe.Error.Data.Status
+			probe.Error.Status = fmt.Sprintf("%d %s", probe.Error.StatusCode, http.StatusText(probe.Error.StatusCode))
+			r.Errors = append(r.Errors, &BatchError{Op: op, Index: i, Err: probe.Error})
+			continue
+		}
+		if err := json.Unmarshal(data, &items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// batch sends req to endpoint in chunks of up to maxBatchSize items and
+// merges the responses. On error the response holds the results of the
+// chunks sent so far.
+func batch[T any](ctx context.Context, c *Client, endpoint string, req *BatchRequest[T]) (*BatchResponse[T], error) {
+	merged := new(BatchResponse[T])
+	for _, chunk := range splitBatch(req) {
+		resp := new(BatchResponse[T])
+		if _, err := c.call(ctx, http.MethodPost, endpoint, nil, chunk, resp); err != nil {
+			return merged, err
+		}
+		for _, e := range resp.Errors {
+			switch e.Op {
+			case "create":
+				e.Index += len(merged.Create)
+			case "update":
+				e.Index += len(merged.Update)
+			case "delete":
+				e.Index += len(merged.Delete)
+			}
+		}
+		merged.Create = append(merged.Create, resp.Create...)
+		merged.Update = append(merged.Update, resp.Update...)
+		merged.Delete = append(merged.Delete, resp.Delete...)
+		merged.Errors = append(merged.Errors, resp.Errors...)
+	}
+	return merged, nil
+}
+
+// splitBatch splits req into requests of at most maxBatchSize items,
+// keeping creates, updates and deletes in order. An empty request is sent
+// as is.
+func splitBatch[T any](req *BatchRequest[T]) []*BatchRequest[T] {
+	if req == nil {
+		req = new(BatchRequest[T])
+	}
+	if len(req.Create)+len(req.Update)+len(req.Delete) <= maxBatchSize {
+		return []*BatchRequest[T]{req}
+	}
+	var chunks []*BatchRequest[T]
+	chunk, n := new(BatchRequest[T]), 0
+	next := func() {
+		n++
+		if n == maxBatchSize {
+			chunks = append(chunks, chunk)
+			chunk, n = new(BatchRequest[T]), 0
+		}
+	}
+	for _, item := range req.Create {
+		chunk.Create = append(chunk.Create, item)
+		next()
+	}
+	for _, item := range req.Update {
+		chunk.Update = append(chunk.Update, item)
+		next()
+	}
+	for _, id := range req.Delete {
+		chunk.Delete = append(chunk.Delete, id)
+		next()
+	}
+	if n > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
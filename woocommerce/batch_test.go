@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSplitBatch(t *testing.T) {
+	req := &BatchRequest[Product]{
+		Create: make([]Product, 150),
+		Update: make([]Product, 30),
+		Delete: make([]int, 40),
+	}
+	var sizes []string
+	for _, chunk := range splitBatch(req) {
+		sizes = append(sizes, fmt.Sprintf("%d/%d/%d", len(chunk.Create), len(chunk.Update), len(chunk.Delete)))
+	}
+	if got := fmt.Sprint(sizes); got != "[100/0/0 50/30/20 0/0/20]" {
+		t.Errorf("chunks = %s", got)
+	}
+	if chunks := splitBatch[Product](nil); len(chunks) != 1 {
+		t.Errorf("nil request split into %d chunks", len(chunks))
+	}
+}
+
+func TestProductsBatchChunks(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/products/batch" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		requests++
+		var req BatchRequest[Product]
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &req)
+		var resp struct {
+			Create []interface{} `json:"create"`
+			Delete []interface{} `json:"delete"`
+		}
+		for _, p := range req.Create {
+			if p.SKU == "dup" {
+				resp.Create = append(resp.Create, map[string]interface{}{
+					"id":    0,
+					"error": map[string]interface{}{"code": "product_invalid_sku", "message": "Invalid or duplicated SKU.", "data": map[string]int{"status": 400}},
+				})
+				continue
+			}
+			resp.Create = append(resp.Create, map[string]interface{}{"id": 1000 + len(resp.Create), "sku": p.SKU})
+		}
+		for _, id := range req.Delete {
+			resp.Delete = append(resp.Delete, map[string]interface{}{"id": id})
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	req := &BatchRequest[Product]{Delete: []int{7, 8}}
+	for i := 0; i < 120; i++ {
+		sku := fmt.Sprintf("sku-%d", i)
+		if i == 3 || i == 110 {
+			sku = "dup"
+		}
+		req.Create = append(req.Create, Product{SKU: sku})
+	}
+	resp, err := client.Products.Batch(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 || len(resp.Create) != 120 || len(resp.Delete) != 2 || resp.Delete[1].ID != 8 {
+		t.Fatalf("requests = %d, create = %d, delete = %+v", requests, len(resp.Create), resp.Delete)
+	}
+	if resp.Create[119].SKU != "sku-119" || resp.Create[3].ID != 0 {
+		t.Errorf("create = %+v, %+v", resp.Create[119], resp.Create[3])
+	}
+	if len(resp.Errors) != 2 || resp.Errors[0].Index != 3 || resp.Errors[1].Index != 110 || resp.Errors[1].Op != "create" {
+		t.Fatalf("errors = %v", resp.Errors)
+	}
+	var apiErr *APIError
+	if !errors.As(resp.Err(), &apiErr) || apiErr.Code != "product_invalid_sku" || apiErr.StatusCode != 400 {
+		t.Errorf("Err() = %v", resp.Err())
+	}
+}
+
+func TestBatchStopsOnRequestError(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 2 {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.Write([]byte(`{"update":[]}`))
+	})
+	resp, err := client.Orders.Batch(context.Background(), &BatchRequest[Order]{Update: make([]Order, 250)})
+	if err == nil || requests != 2 || resp == nil {
+		t.Errorf("err = %v, requests = %d, resp = %v", err, requests, resp)
+	}
+}
@@ -0,0 +1,394 @@
+// Package boltwc mirrors the products, orders and customers of a store in
+// a local bbolt database and answers queries from it.
+//
+//	mirror, err := boltwc.Open("store.db", client)
+//	defer mirror.Close()
+//	err = mirror.Sync(ctx)
+//	processing, err := mirror.Orders(func(o *woocommerce.Order) bool {
+//		return o.Status == "processing"
+//	})
+//
+// The first Sync copies everything. Later syncs only fetch products and
+// orders modified since the latest modification the previous sync saw,
+// paging by modification time with woocommerce.ListModified, and drop
+// those moved to the trash; customers cannot be filtered by date and are
+// copied in full each time. Items deleted permanently are only noticed by
+// FullSync. Webhook deliveries can be applied between syncs with Put and
+// Delete.
+package boltwc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Resources mirrored, used as bucket names and endpoints.
+const (
+	Products  = "products"
+	Orders    = "orders"
+	Customers = "customers"
+)
+
+// ErrNotFound is returned for items that are not in the mirror.
+var ErrNotFound = errors.New("Item is not in the mirror")
+
+var metaBucket = []byte("meta")
+
+// resources lists what is mirrored and whether it can be synced
+// incrementally with modified_after.
+var resources = []struct {
+	name        string
+	incremental bool
+}{
+	{Products, true},
+	{Orders, true},
+	{Customers, false},
+}
+
+// Mirror is a local copy of a store. It is safe for concurrent use.
+type Mirror struct {
+	db     *bolt.DB
+	client woocommerce.API
+}
+
+// Open opens or creates the database at path and mirrors the store client
+// talks to.
+func Open(path string, client woocommerce.API) (*Mirror, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range []string{Products, Orders, Customers, string(metaBucket)} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Mirror{db: db, client: client}, nil
+}
+
+func (m *Mirror) Close() error {
+	return m.db.Close()
+}
+
+// Sync brings every resource up to date, incrementally where possible.
+func (m *Mirror) Sync(ctx context.Context) error {
+	for _, r := range resources {
+		if err := m.sync(ctx, r.name, r.incremental, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FullSync copies every resource again, removing items that no longer
+// exist in the store. Removal is skipped for a resource whose number of
+// items changed while it was copied, as items deleted meanwhile can hide
+// others from the listing; a later FullSync removes them.
+func (m *Mirror) FullSync(ctx context.Context) error {
+	for _, r := range resources {
+		if err := m.sync(ctx, r.name, r.incremental, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastSync returns when resource was last synced, or the zero time.
+func (m *Mirror) LastSync(resource string) (time.Time, error) {
+	var t time.Time
+	err := m.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(metaBucket).Get([]byte(resource + ".synced"))
+		if data == nil {
+			return nil
+		}
+		return t.UnmarshalText(data)
+	})
+	return t, err
+}
+
+// modified returns the latest modification time seen by the syncs of
+// resource, and false before its first sync.
+func (m *Mirror) modified(resource string) (time.Time, bool, error) {
+	var t time.Time
+	var ok bool
+	err := m.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(metaBucket).Get([]byte(resource + ".modified"))
+		if data == nil {
+			return nil
+		}
+		ok = true
+		return t.UnmarshalText(data)
+	})
+	return t, ok, err
+}
+
+func (m *Mirror) sync(ctx context.Context, resource string, incremental, full bool) error {
+	start := time.Now().UTC()
+	modified, ok, err := m.modified(resource)
+	if err != nil {
+		return err
+	}
+	if !incremental || !ok {
+		full = true
+	}
+	var total int
+	if full {
+		if total, err = m.count(ctx, resource); err != nil {
+			return err
+		}
+	}
+	cursor := new(woocommerce.SyncCursor)
+	seen := make(map[uint64]bool)
+	store := func(items []json.RawMessage) error {
+		return m.store(resource, items, seen)
+	}
+	switch {
+	case !incremental:
+		// Items are listed by ID, so items created during the walk are
+		// added at the end.
+		err = m.client.ListAll(ctx, resource, url.Values{"orderby": {"id"}, "order": {"asc"}}, store)
+	case full:
+		err = woocommerce.ListModified(ctx, m.client, resource, nil, cursor, store)
+	default:
+		// The cursor starts without the IDs seen last time, so that items
+		// modified again within the same second are fetched again. Trashed
+		// items are left out of the listing of modified items.
+		cursor.Modified = modified
+		trashed := &woocommerce.SyncCursor{Modified: modified}
+		err = woocommerce.ListModified(ctx, m.client, resource, nil, cursor, store)
+		if err == nil {
+			err = woocommerce.ListModified(ctx, m.client, resource, url.Values{"status": {"trash"}}, trashed, func(items []json.RawMessage) error {
+				return m.remove(resource, items)
+			})
+		}
+	}
+	if err != nil {
+		return err
+	}
+	purge := false
+	if full {
+		after, err := m.count(ctx, resource)
+		if err != nil {
+			return err
+		}
+		purge = after == total
+	}
+	return m.db.Update(func(tx *bolt.Tx) error {
+		if purge {
+			b := tx.Bucket([]byte(resource))
+			var stale [][]byte
+			err := b.ForEach(func(k, v []byte) error {
+				if !seen[binary.BigEndian.Uint64(k)] {
+					stale = append(stale, k)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, k := range stale {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+		meta := tx.Bucket(metaBucket)
+		if incremental {
+			data, err := cursor.Modified.MarshalText()
+			if err != nil {
+				return err
+			}
+			if err := meta.Put([]byte(resource+".modified"), data); err != nil {
+				return err
+			}
+		}
+		data, err := start.MarshalText()
+		if err != nil {
+			return err
+		}
+		return meta.Put([]byte(resource+".synced"), data)
+	})
+}
+
+// count returns the number of items of resource in the store.
+func (m *Mirror) count(ctx context.Context, resource string) (int, error) {
+	resp, err := m.client.Get(ctx, resource, url.Values{"per_page": {"1"}, "_fields": {"id"}})
+	if err != nil {
+		return 0, err
+	}
+	resp.Close()
+	return resp.Total, nil
+}
+
+// remove deletes one page of items from the mirror.
+func (m *Mirror) remove(resource string, items []json.RawMessage) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(resource))
+		for _, raw := range items {
+			var item struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			if err := b.Delete(itob(item.ID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// store writes one page of items, recording their IDs in seen.
+func (m *Mirror) store(resource string, items []json.RawMessage, seen map[uint64]bool) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(resource))
+		for _, raw := range items {
+			var item struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			seen[uint64(item.ID)] = true
+			if err := b.Put(itob(item.ID), raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Put stores item, a *woocommerce.Product, *woocommerce.Order or
+// *woocommerce.Customer, e.g. from a webhook delivery.
+func (m *Mirror) Put(item interface{}) error {
+	var resource string
+	var id int
+	switch item := item.(type) {
+	case *woocommerce.Product:
+		resource, id = Products, item.ID
+	case *woocommerce.Order:
+		resource, id = Orders, item.ID
+	case *woocommerce.Customer:
+		resource, id = Customers, item.ID
+	default:
+		return errors.New("Item type is not mirrored")
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return m.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(resource)).Put(itob(id), data)
+	})
+}
+
+// Delete removes the item id of resource from the mirror.
+func (m *Mirror) Delete(resource string, id int) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(resource))
+		if b == nil {
+			return errors.New("Resource is not mirrored: " + resource)
+		}
+		return b.Delete(itob(id))
+	})
+}
+
+func (m *Mirror) Product(id int) (*woocommerce.Product, error) {
+	return get[woocommerce.Product](m, Products, id)
+}
+
+func (m *Mirror) Order(id int) (*woocommerce.Order, error) {
+	return get[woocommerce.Order](m, Orders, id)
+}
+
+func (m *Mirror) Customer(id int) (*woocommerce.Customer, error) {
+	return get[woocommerce.Customer](m, Customers, id)
+}
+
+// Products returns the mirrored products for which match returns true, in
+// ID order. A nil match returns every product.
+func (m *Mirror) Products(match func(*woocommerce.Product) bool) ([]woocommerce.Product, error) {
+	return scan(m, Products, match)
+}
+
+// Orders returns the mirrored orders for which match returns true, in ID
+// order. A nil match returns every order.
+func (m *Mirror) Orders(match func(*woocommerce.Order) bool) ([]woocommerce.Order, error) {
+	return scan(m, Orders, match)
+}
+
+// Customers returns the mirrored customers for which match returns true,
+// in ID order. A nil match returns every customer.
+func (m *Mirror) Customers(match func(*woocommerce.Customer) bool) ([]woocommerce.Customer, error) {
+	return scan(m, Customers, match)
+}
+
+// ProductBySKU returns the mirrored product with sku.
+func (m *Mirror) ProductBySKU(sku string) (*woocommerce.Product, error) {
+	products, err := m.Products(func(p *woocommerce.Product) bool { return p.SKU == sku })
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, ErrNotFound
+	}
+	return &products[0], nil
+}
+
+// CustomerOrders returns the mirrored orders of the customer id.
+func (m *Mirror) CustomerOrders(customerID int) ([]woocommerce.Order, error) {
+	return m.Orders(func(o *woocommerce.Order) bool { return o.CustomerID == customerID })
+}
+
+func get[T any](m *Mirror, resource string, id int) (*T, error) {
+	item := new(T)
+	err := m.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(resource)).Get(itob(id))
+		if data == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(data, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func scan[T any](m *Mirror, resource string, match func(*T) bool) ([]T, error) {
+	var items []T
+	err := m.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(resource)).ForEach(func(k, v []byte) error {
+			var item T
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			if match == nil || match(&item) {
+				items = append(items, item)
+			}
+			return nil
+		})
+	})
+	return items, err
+}
+
+func itob(id int) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
@@ -0,0 +1,156 @@
+package boltwc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+	"github.com/mikespook/wc-api-golang/woocommerce/woocommercetest"
+)
+
+func TestMirror(t *testing.T) {
+	store := woocommercetest.NewServer()
+	defer store.Close()
+	hoodie := store.AddProduct(woocommerce.Product{Name: "Hoodie", SKU: "H-1"})
+	mug := store.AddProduct(woocommerce.Product{Name: "Mug", SKU: "M-1"})
+	beanie := store.AddProduct(woocommerce.Product{Name: "Cap", SKU: "C-1"})
+	customer := store.AddCustomer(woocommerce.Customer{Email: "john@example.com"})
+	store.AddOrder(woocommerce.Order{CustomerID: customer.ID, Status: "processing"})
+	store.AddOrder(woocommerce.Order{Status: "completed"})
+	client, err := store.Client(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mirror, err := Open(filepath.Join(t.TempDir(), "store.db"), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mirror.Close()
+	ctx := context.Background()
+	if err := mirror.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if last, _ := mirror.LastSync(Products); last.IsZero() {
+		t.Error("sync time not recorded")
+	}
+	if p, err := mirror.ProductBySKU("M-1"); err != nil || p.ID != mug.ID {
+		t.Errorf("product by SKU = %+v, %v", p, err)
+	}
+	if orders, err := mirror.CustomerOrders(customer.ID); err != nil || len(orders) != 1 || orders[0].Status != "processing" {
+		t.Errorf("customer orders = %+v, %v", orders, err)
+	}
+	if c, err := mirror.Customer(customer.ID); err != nil || c.Email != "john@example.com" {
+		t.Errorf("customer = %+v, %v", c, err)
+	}
+
+	// An incremental sync picks up edits and trashed items but cannot see
+	// permanent deletions.
+	if _, err := client.Products.Update(ctx, hoodie.ID, &woocommerce.Product{Name: "Zip Hoodie"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Products.Delete(ctx, mug.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Products.Delete(ctx, beanie.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := mirror.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := mirror.Product(hoodie.ID); p == nil || p.Name != "Zip Hoodie" {
+		t.Errorf("hoodie = %+v", p)
+	}
+	if _, err := mirror.Product(mug.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("trashed product err = %v", err)
+	}
+	if _, err := mirror.Product(beanie.ID); err != nil {
+		t.Errorf("deleted product should remain until a full sync: %v", err)
+	}
+	if err := mirror.FullSync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if products, _ := mirror.Products(nil); len(products) != 1 || products[0].ID != hoodie.ID {
+		t.Errorf("products after full sync = %+v", products)
+	}
+
+	// Webhook deliveries can be applied between syncs.
+	if err := mirror.Put(&woocommerce.Order{ID: 900, Status: "on-hold"}); err != nil {
+		t.Fatal(err)
+	}
+	if o, err := mirror.Order(900); err != nil || o.Status != "on-hold" {
+		t.Errorf("order = %+v, %v", o, err)
+	}
+	if err := mirror.Delete(Orders, 900); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mirror.Order(900); !errors.Is(err, ErrNotFound) {
+		t.Errorf("deleted order err = %v", err)
+	}
+}
+
+func TestMirrorFullSyncDeletedDuringWalk(t *testing.T) {
+	store := woocommercetest.NewServer()
+	defer store.Close()
+	var products []woocommerce.Product
+	for i := 0; i < woocommerce.DefaultPerPage+50; i++ {
+		products = append(products, store.AddProduct(woocommerce.Product{Name: "Sticker"}))
+	}
+	client, err := store.Client(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first product is deleted once the mirror has read the first
+	// page of a walk, shifting the rest of the listing back by one.
+	var armed atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wp-json/wc/v3/products" && r.URL.Query().Get("page") == "2" && armed.CompareAndSwap(true, false) {
+			if _, err := client.Products.Delete(r.Context(), products[0].ID, true); err != nil {
+				t.Error(err)
+			}
+		}
+		store.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	walker, err := woocommerce.NewClient(srv.URL, store.ConsumerKey, store.ConsumerSecret, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mirror, err := Open(filepath.Join(t.TempDir(), "store.db"), walker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mirror.Close()
+	ctx := context.Background()
+	if err := mirror.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	armed.Store(true)
+	if err := mirror.FullSync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if armed.Load() {
+		t.Fatal("no product was deleted during the walk")
+	}
+	for _, p := range products[1:] {
+		if _, err := mirror.Product(p.ID); err != nil {
+			t.Fatalf("product %d: %v", p.ID, err)
+		}
+	}
+
+	if err := mirror.FullSync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mirror.Products(nil); len(got) != len(products)-1 {
+		t.Errorf("mirrored %d products, want %d", len(got), len(products)-1)
+	}
+	if _, err := mirror.Product(products[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("deleted product err = %v", err)
+	}
+}
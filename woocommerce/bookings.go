@@ -0,0 +1,195 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// BookingsService reads and creates bookings, availability slots and
+// resources of the WooCommerce Bookings extension at
+// /wp-json/wc-bookings/v1.
+type BookingsService service
+
+const bookingsEndpoint = "/wc-bookings/v1"
+
+// Booking status values.
+const (
+	BookingStatusUnpaid              = "unpaid"
+	BookingStatusPendingConfirmation = "pending-confirmation"
+	BookingStatusConfirmed           = "confirmed"
+	BookingStatusPaid                = "paid"
+	BookingStatusCancelled           = "cancelled"
+	BookingStatusComplete            = "complete"
+	BookingStatusInCart              = "in-cart"
+)
+
+// Booking is a booking of a bookable product. Start and End are Unix
+// timestamps of the store's wall clock; see StartTime and EndTime.
+type Booking struct {
+	ID                    int          `json:"id,omitempty"`
+	ProductID             int          `json:"product_id,omitempty"`
+	ResourceID            int          `json:"resource_id,omitempty"`
+	CustomerID            int          `json:"customer_id,omitempty"`
+	OrderID               int          `json:"order_id,omitempty"`
+	OrderItemID           int          `json:"order_item_id,omitempty"`
+	ParentID              int          `json:"parent_id,omitempty"`
+	Status                string       `json:"status,omitempty"`
+	Start                 int64        `json:"start,omitempty"`
+	End                   int64        `json:"end,omitempty"`
+	AllDay                *bool        `json:"all_day,omitempty"`
+	Cost                  Decimal      `json:"cost,omitzero"`
+	PersonCounts          PersonCounts `json:"person_counts,omitempty"`
+	LocalTimezone         string       `json:"local_timezone,omitempty"`
+	GoogleCalendarEventID string       `json:"google_calendar_event_id,omitempty"`
+	DateCreated           int64        `json:"date_created,omitempty"`
+	DateModified          int64        `json:"date_modified,omitempty"`
+}
+
+// StartTime returns the start of the booking as a wall clock time in loc,
+// the store's timezone.
+func (b *Booking) StartTime(loc *time.Location) time.Time {
+	return bookingTime(b.Start, loc)
+}
+
+// EndTime returns the end of the booking as a wall clock time in loc, the
+// store's timezone.
+func (b *Booking) EndTime(loc *time.Location) time.Time {
+	return bookingTime(b.End, loc)
+}
+
+func bookingTime(ts int64, loc *time.Location) time.Time {
+	return WCTime{time.Unix(ts, 0).UTC()}.Wall(loc)
+}
+
+// PersonCounts maps person type IDs to the number of persons of that type.
+// Products without person types have a single entry for ID 0.
+type PersonCounts map[int]int
+
+// UnmarshalJSON accepts the empty array PHP sends for an empty map.
+func (p *PersonCounts) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("[]")) {
+		*p = nil
+		return nil
+	}
+	var counts map[int]int
+	if err := json.Unmarshal(data, &counts); err != nil {
+		return err
+	}
+	*p = counts
+	return nil
+}
+
+// BookingResource is a resource, such as a room or a guide, that bookable
+// products can be booked with.
+type BookingResource struct {
+	ID           int               `json:"id,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	Qty          int               `json:"qty,omitempty"`
+	Role         string            `json:"role,omitempty"`
+	SortOrder    int               `json:"sort_order,omitempty"`
+	Availability []json.RawMessage `json:"availability,omitempty"`
+}
+
+// SlotParams selects the availability slots to return.
+type SlotParams struct {
+	ProductIDs  []int
+	ResourceIDs []int
+	// MinDate and MaxDate bound the slots, in the store's timezone.
+	MinDate time.Time
+	MaxDate time.Time
+	// HideUnavailable leaves out fully booked slots.
+	HideUnavailable bool
+	Page            int
+	Limit           int
+}
+
+func (p *SlotParams) values() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if len(p.ProductIDs) > 0 {
+		query.Set("product_ids", joinInts(p.ProductIDs))
+	}
+	if len(p.ResourceIDs) > 0 {
+		query.Set("resource_ids", joinInts(p.ResourceIDs))
+	}
+	if !p.MinDate.IsZero() {
+		query.Set("min_date", p.MinDate.Format(wcTimeLayout))
+	}
+	if !p.MaxDate.IsZero() {
+		query.Set("max_date", p.MaxDate.Format(wcTimeLayout))
+	}
+	if p.HideUnavailable {
+		query.Set("hide_unavailable", "true")
+	}
+	if p.Page > 0 {
+		query.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	return query
+}
+
+// Slot is a bookable block of time. Duration is in the product's duration
+// unit.
+type Slot struct {
+	Date       WCTime `json:"date"`
+	Duration   int    `json:"duration"`
+	Available  int    `json:"available"`
+	Booked     int    `json:"booked"`
+	ProductID  int    `json:"product_id"`
+	ResourceID int    `json:"resource_id,omitempty"`
+}
+
+func (s *BookingsService) List(ctx context.Context, params *ListParams) ([]Booking, *Response, error) {
+	var bookings []Booking
+	resp, err := s.client.list(ctx, bookingsEndpoint+"/bookings", params, &bookings)
+	return bookings, resp, err
+}
+
+// ListAll calls fn with every page of bookings matching params.
+func (s *BookingsService) ListAll(ctx context.Context, params *ListParams, fn func([]Booking) error) error {
+	return listAll(ctx, s.client, bookingsEndpoint+"/bookings", params, fn)
+}
+
+func (s *BookingsService) Get(ctx context.Context, id int) (*Booking, error) {
+	booking := new(Booking)
+	_, err := s.client.call(ctx, http.MethodGet, bookingsEndpoint+"/bookings/"+buildPath(id), nil, nil, booking)
+	return booking, err
+}
+
+// Create creates a booking. ProductID, Start and End are required;
+// bookings without an order are created unpaid.
+func (s *BookingsService) Create(ctx context.Context, booking *Booking) (*Booking, error) {
+	created := new(Booking)
+	_, err := s.client.call(ctx, http.MethodPost, bookingsEndpoint+"/bookings", nil, booking, created)
+	return created, err
+}
+
+// Slots returns availability slots of bookable products.
+func (s *BookingsService) Slots(ctx context.Context, params *SlotParams) ([]Slot, error) {
+	var slots struct {
+		Records []Slot `json:"records"`
+	}
+	_, err := s.client.call(ctx, http.MethodGet, bookingsEndpoint+"/products/slots", params.values(), nil, &slots)
+	return slots.Records, err
+}
+
+func (s *BookingsService) Resources(ctx context.Context, params *ListParams) ([]BookingResource, *Response, error) {
+	var resources []BookingResource
+	resp, err := s.client.list(ctx, bookingsEndpoint+"/resources", params, &resources)
+	return resources, resp, err
+}
+
+func (s *BookingsService) Resource(ctx context.Context, id int) (*BookingResource, error) {
+	resource := new(BookingResource)
+	_, err := s.client.call(ctx, http.MethodGet, bookingsEndpoint+"/resources/"+buildPath(id), nil, nil, resource)
+	return resource, err
+}
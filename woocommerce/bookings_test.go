@@ -0,0 +1,77 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const bookingJSON = `{"id":120,"product_id":52,"resource_id":9,"customer_id":25,"order_id":0,"status":"unpaid","start":1717232400,"end":1717236000,"all_day":false,"cost":"45.00","person_counts":[],"date_created":1717000000}`
+
+func TestBookings(t *testing.T) {
+	var method, path string
+	var query url.Values
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, query, body = r.Method, r.URL.Path, r.URL.Query(), nil
+		json.NewDecoder(r.Body).Decode(&body)
+		switch path {
+		case "/wp-json/wc-bookings/v1/bookings":
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(bookingJSON))
+				return
+			}
+			w.Write([]byte(`[` + bookingJSON + `,{"id":121,"product_id":52,"person_counts":{"0":2,"31":1}}]`))
+		case "/wp-json/wc-bookings/v1/products/slots":
+			w.Write([]byte(`{"records":[{"date":"2024-06-01T09:00","duration":1,"available":2,"booked":1,"product_id":52}],"count":1}`))
+		case "/wp-json/wc-bookings/v1/resources":
+			w.Write([]byte(`[{"id":9,"name":"Kayak","qty":4,"sort_order":1}]`))
+		}
+	})
+	ctx := context.Background()
+
+	bookings, _, err := client.Bookings.List(ctx, NewListParams().Status(BookingStatusUnpaid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bookings) != 2 || bookings[0].Cost.String() != "45.00" || bookings[0].PersonCounts != nil || bookings[1].PersonCounts[31] != 1 {
+		t.Errorf("bookings = %+v", bookings)
+	}
+	if start := bookings[0].StartTime(time.UTC); start.Hour() != 9 || start.Day() != 1 {
+		t.Errorf("start = %v", start)
+	}
+
+	if _, err := client.Bookings.Create(ctx, &Booking{ProductID: 52, Start: 1717232400, End: 1717236000, PersonCounts: PersonCounts{0: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["product_id"] != 52.0 || body["person_counts"].(map[string]interface{})["0"] != 2.0 {
+		t.Errorf("create sent %s %v", method, body)
+	}
+
+	slots, err := client.Bookings.Slots(ctx, &SlotParams{
+		ProductIDs:      []int{52, 53},
+		MinDate:         time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		HideUnavailable: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("product_ids") != "52,53" || query.Get("min_date") != "2024-06-01T00:00:00" || query.Get("hide_unavailable") != "true" {
+		t.Errorf("slots query = %v", query)
+	}
+	if len(slots) != 1 || slots[0].Available != 2 || slots[0].Date.Hour() != 9 {
+		t.Errorf("slots = %+v", slots)
+	}
+
+	resources, _, err := client.Bookings.Resources(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 1 || resources[0].Name != "Kayak" || resources[0].Qty != 4 {
+		t.Errorf("resources = %+v", resources)
+	}
+}
@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned, wrapped with the store's host, for requests
+// refused by an open CircuitBreaker.
+var ErrCircuitOpen = errors.New("Circuit breaker is open")
+
+// CircuitBreaker stops sending requests to a store that keeps failing, so
+// that batch pipelines fail fast instead of hammering a store that is down
+// for maintenance. Set it as Option.CircuitBreaker; one breaker may be
+// shared by clients for several stores, as it tracks each host separately.
+//
+// Network errors and 5xx responses count as failures; any other response
+// closes the circuit again. After Threshold consecutive failures requests
+// fail with ErrCircuitOpen until Cooldown has passed, when a single trial
+// request is let through to probe the store.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failures that opens the
+	// circuit. Defaults to 5.
+	Threshold int
+	// Cooldown is how long the circuit stays open. Defaults to 30s.
+	Cooldown time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*circuit
+}
+
+type circuit struct {
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// allow reports whether a request to host may be sent. A nil breaker
+// allows everything.
+func (b *CircuitBreaker) allow(host string) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.hosts[host]
+	if c == nil || c.failures < b.threshold() {
+		return nil
+	}
+	if c.trial || time.Since(c.openedAt) < b.cooldown() {
+		return fmt.Errorf("%w: %s", ErrCircuitOpen, host)
+	}
+	c.trial = true
+	return nil
+}
+
+// record updates the circuit for host with the outcome of a request.
+func (b *CircuitBreaker) record(host string, status int, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hosts == nil {
+		b.hosts = map[string]*circuit{}
+	}
+	c := b.hosts[host]
+	if c == nil {
+		c = &circuit{}
+		b.hosts[host] = c
+	}
+	c.trial = false
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		// The caller gave up; this says nothing about the store.
+	case err != nil || status >= 500:
+		c.failures++
+		if c.failures >= b.threshold() {
+			c.openedAt = time.Now()
+		}
+	default:
+		c.failures = 0
+	}
+}
+
+func (b *CircuitBreaker) threshold() int {
+	if b.Threshold <= 0 {
+		return 5
+	}
+	return b.Threshold
+}
+
+func (b *CircuitBreaker) cooldown() time.Duration {
+	if b.Cooldown <= 0 {
+		return 30 * time.Second
+	}
+	return b.Cooldown
+}
@@ -0,0 +1,82 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var calls, down int32 = 0, 1
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	breaker := &CircuitBreaker{Threshold: 2, Cooldown: 50 * time.Millisecond}
+	policy := &RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond}
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Retry: policy, CircuitBreaker: breaker})
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func() error {
+		body, err := client.Get(context.Background(), "products", nil)
+		if err == nil {
+			body.Close()
+		}
+		return err
+	}
+
+	// The second failure opens the circuit, cutting the retries short.
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("calls = %d, want 2", n)
+	}
+
+	// After the cooldown a failed trial reopens it, a successful one closes it.
+	time.Sleep(60 * time.Millisecond)
+	if err := get(); !errors.Is(err, ErrCircuitOpen) || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("trial: err = %v after %d calls", err, atomic.LoadInt32(&calls))
+	}
+	time.Sleep(60 * time.Millisecond)
+	atomic.StoreInt32(&down, 0)
+	if err := get(); err != nil {
+		t.Fatal(err)
+	}
+	if err := get(); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 5 {
+		t.Errorf("calls = %d, want 5", n)
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	breaker := &CircuitBreaker{Threshold: 1}
+	breaker.record("a.example", http.StatusNotFound, nil)
+	breaker.record("b.example", 0, context.Canceled)
+	if err := breaker.allow("a.example"); err != nil {
+		t.Error(err)
+	}
+	if err := breaker.allow("b.example"); err != nil {
+		t.Error(err)
+	}
+	breaker.record("a.example", http.StatusBadGateway, nil)
+	if err := breaker.allow("a.example"); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("err = %v, want ErrCircuitOpen", err)
+	}
+	if err := breaker.allow("b.example"); err != nil {
+		t.Errorf("other host: %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBulkWorkers is the number of concurrent requests BulkFetch makes
+// when BulkOptions.Workers is not set.
+const DefaultBulkWorkers = 4
+
+// BulkOptions configures BulkFetch.
+type BulkOptions struct {
+	// Workers is the number of pages requested concurrently. Requests still
+	// wait for the client's rate limiter.
+	Workers int
+	// Ordered delivers pages in order. Otherwise they are delivered as soon
+	// as they arrive.
+	Ordered bool
+	// IDs, if set, fetches these items with include= in chunks of per_page
+	// instead of walking every page of the listing.
+	IDs []int
+}
+
+type bulkResult struct {
+	index int
+	items []json.RawMessage
+	err   error
+}
+
+// BulkFetch reads a list endpoint with several requests in flight, calling
+// fn with the items of each page. The first page is read on its own to
+// learn the page count from X-WP-TotalPages; the rest are spread across
+// the workers. fn is never called concurrently. The first error, from a
+// request or from fn, cancels the remaining requests and is returned.
+//
+// Unlike ListAll the page count is fixed after the first page, so items
+// created or deleted while fetching can be missed or seen twice; fetch by
+// IDs when that matters.
+func (c *Client) BulkFetch(ctx context.Context, endpoint string, params url.Values, opts *BulkOptions, fn func(items []json.RawMessage) error) error {
+	if opts == nil {
+		opts = &BulkOptions{}
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultBulkWorkers
+	}
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = append([]string(nil), v...)
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+	query.Set("per_page", strconv.Itoa(perPage))
+
+	var tasks []url.Values
+	if opts.IDs != nil {
+		query.Del("page")
+		for start := 0; start < len(opts.IDs); start += perPage {
+			chunk := opts.IDs[start:min(start+perPage, len(opts.IDs))]
+			ids := make([]string, len(chunk))
+			for i, id := range chunk {
+				ids[i] = strconv.Itoa(id)
+			}
+			task := cloneValues(query)
+			task.Set("include", strings.Join(ids, ","))
+			task.Set("per_page", strconv.Itoa(len(chunk)))
+			tasks = append(tasks, task)
+		}
+	} else {
+		query.Set("page", "1")
+		items, resp, err := c.fetchPage(ctx, endpoint, query)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+		for page := 2; page <= resp.TotalPages; page++ {
+			task := cloneValues(query)
+			task.Set("page", strconv.Itoa(page))
+			tasks = append(tasks, task)
+		}
+	}
+	return c.fetchPages(ctx, endpoint, tasks, min(workers, len(tasks)), opts.Ordered, fn)
+}
+
+func (c *Client) fetchPages(ctx context.Context, endpoint string, tasks []url.Values, workers int, ordered bool, fn func(items []json.RawMessage) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan int)
+	results := make(chan bulkResult)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				items, _, err := c.fetchPage(ctx, endpoint, tasks[i])
+				select {
+				case results <- bulkResult{index: i, items: items, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := range tasks {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var firstErr error
+	fail := func(err error) {
+		firstErr = err
+		cancel()
+	}
+	pending := make(map[int][]json.RawMessage)
+	next := 0
+	for r := range results {
+		switch {
+		case firstErr != nil:
+		case r.err != nil:
+			fail(r.err)
+		case !ordered:
+			if err := fn(r.items); err != nil {
+				fail(err)
+			}
+		default:
+			pending[r.index] = r.items
+			for items, ok := pending[next]; ok && firstErr == nil; items, ok = pending[next] {
+				delete(pending, next)
+				next++
+				if err := fn(items); err != nil {
+					fail(err)
+				}
+			}
+		}
+	}
+	return firstErr
+}
+
+func (c *Client) fetchPage(ctx context.Context, endpoint string, query url.Values) ([]json.RawMessage, *Response, error) {
+	resp, err := c.Get(ctx, endpoint, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Close()
+	var items []json.RawMessage
+	if err := json.NewDecoder(resp).Decode(&items); err != nil {
+		return nil, nil, err
+	}
+	return items, resp, nil
+}
+
+func bulkFetch[T any](ctx context.Context, c *Client, endpoint string, params *ListParams, opts *BulkOptions, fn func([]T) error) error {
+	query, err := params.Values()
+	if err != nil {
+		return err
+	}
+	return c.BulkFetch(ctx, endpoint, query, opts, func(items []json.RawMessage) error {
+		page := make([]T, len(items))
+		for i, raw := range items {
+			if err := json.Unmarshal(raw, &page[i]); err != nil {
+				return err
+			}
+		}
+		return fn(page)
+	})
+}
+
+func cloneValues(v url.Values) url.Values {
+	clone := make(url.Values, len(v))
+	for k, vs := range v {
+		clone[k] = append([]string(nil), vs...)
+	}
+	return clone
+}
@@ -0,0 +1,97 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newBulkServer(t *testing.T, total int, requests *int) *Client {
+	var mu sync.Mutex
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*requests++
+		mu.Unlock()
+		q := r.URL.Query()
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		var ids []int
+		if include := q.Get("include"); include != "" {
+			for _, s := range strings.Split(include, ",") {
+				id, _ := strconv.Atoi(s)
+				ids = append(ids, id)
+			}
+		} else {
+			page, _ := strconv.Atoi(q.Get("page"))
+			for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+				ids = append(ids, id)
+			}
+			// Later pages answer first, to exercise ordering.
+			time.Sleep(time.Duration(total/perPage-page+1) * time.Millisecond)
+		}
+		w.Header().Set("X-WP-TotalPages", strconv.Itoa((total+perPage-1)/perPage))
+		items := []map[string]int{}
+		for _, id := range ids {
+			items = append(items, map[string]int{"id": id})
+		}
+		json.NewEncoder(w).Encode(items)
+	})
+}
+
+func TestBulkFetch(t *testing.T) {
+	var requests int
+	client := newBulkServer(t, 10, &requests)
+	ctx := context.Background()
+	var ids []int
+	err := client.Products.BulkFetch(ctx, NewListParams().PerPage(3), &BulkOptions{Workers: 3, Ordered: true}, func(page []Product) error {
+		for _, p := range page {
+			ids = append(ids, p.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5 6 7 8 9 10]" || requests != 4 {
+		t.Errorf("ids = %v after %d requests", ids, requests)
+	}
+
+	ids, requests = nil, 0
+	err = client.Orders.BulkFetch(ctx, NewListParams().PerPage(2), &BulkOptions{IDs: []int{5, 3, 9, 1, 7}}, func(page []Order) error {
+		for _, o := range page {
+			ids = append(ids, o.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Ints(ids)
+	if fmt.Sprint(ids) != "[1 3 5 7 9]" || requests != 3 {
+		t.Errorf("ids = %v after %d requests", ids, requests)
+	}
+}
+
+func TestBulkFetchStopsOnError(t *testing.T) {
+	var requests int
+	client := newBulkServer(t, 50, &requests)
+	stop := errors.New("stop")
+	calls := 0
+	err := client.Customers.BulkFetch(context.Background(), NewListParams().PerPage(5), &BulkOptions{Workers: 2}, func(page []Customer) error {
+		calls++
+		if calls == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || calls != 2 {
+		t.Errorf("err = %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,120 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestResponseCache(t *testing.T) {
+	var requests, notModified int
+	body := `[{"id":794,"name":"Premium Quality"}]`
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("X-WP-Total", "1")
+		w.Write([]byte(body))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Cache: NewMemoryCache()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		products, resp, err := client.Products.List(ctx, NewListParams().PerPage(10))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(products) != 1 || products[0].ID != 794 || resp.Total != 1 {
+			t.Errorf("attempt %d: products = %+v, total = %d", i, products, resp.Total)
+		}
+	}
+	if requests != 3 || notModified != 2 {
+		t.Errorf("requests = %d, not modified = %d", requests, notModified)
+	}
+
+	// Other queries are cached separately.
+	if _, _, err := client.Products.List(ctx, NewListParams().PerPage(20)); err != nil {
+		t.Fatal(err)
+	}
+	if notModified != 2 {
+		t.Errorf("different query was sent conditionally")
+	}
+}
+
+func TestResponseCacheLastModified(t *testing.T) {
+	var since string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		since = r.Header.Get("If-Modified-Since")
+		if r.Method == http.MethodGet && since != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		w.Write([]byte(`{"id":1}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Cache: NewMemoryCache()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		order, err := client.Orders.Get(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if order.ID != 1 {
+			t.Errorf("order = %+v", order)
+		}
+	}
+	if since != "Wed, 21 Oct 2015 07:28:00 GMT" {
+		t.Errorf("If-Modified-Since = %q", since)
+	}
+	if _, err := client.Orders.Update(ctx, 1, &Order{}); err != nil {
+		t.Fatal(err)
+	}
+	if since != "" {
+		t.Errorf("PUT sent If-Modified-Since %q", since)
+	}
+}
+
+func TestResponseCacheSharedByStores(t *testing.T) {
+	cache := NewMemoryCache()
+	newStore := func(id int) (*Client, *int) {
+		notModified := new(int)
+		srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				*notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			// Both stores tag their catalog "v1".
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprintf(w, `[{"id":%d}]`, id)
+		})
+		client, err := NewClient(srv.URL, "ck", "cs", &Option{Cache: cache})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client, notModified
+	}
+	first, _ := newStore(1)
+	second, notModified := newStore(2)
+	ctx := context.Background()
+	if _, _, err := first.Products.List(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	products, _, err := second.Products.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *notModified != 0 || len(products) != 1 || products[0].ID != 2 {
+		t.Errorf("second store got %+v from the cache of the first", products)
+	}
+}
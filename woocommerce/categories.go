@@ -0,0 +1,155 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ProductCategoriesService manages /products/categories.
+type ProductCategoriesService service
+
+type ProductCategory struct {
+	ID          int           `json:"id,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	Slug        string        `json:"slug,omitempty"`
+	Parent      int           `json:"parent,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Display     string        `json:"display,omitempty"`
+	Image       *ProductImage `json:"image,omitempty"`
+	MenuOrder   int           `json:"menu_order,omitempty"`
+	Count       int           `json:"count,omitempty"`
+}
+
+// CategoryNode is a category with its subcategories.
+type CategoryNode struct {
+	ProductCategory
+	Children []*CategoryNode
+}
+
+func (s *ProductCategoriesService) List(ctx context.Context, params *ListParams) ([]ProductCategory, *Response, error) {
+	var categories []ProductCategory
+	resp, err := s.client.list(ctx, "products/categories", params, &categories)
+	return categories, resp, err
+}
+
+// ListAll calls fn with every page of categories matching params.
+func (s *ProductCategoriesService) ListAll(ctx context.Context, params *ListParams, fn func([]ProductCategory) error) error {
+	return listAll(ctx, s.client, "products/categories", params, fn)
+}
+
+func (s *ProductCategoriesService) Get(ctx context.Context, id int) (*ProductCategory, error) {
+	category := new(ProductCategory)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "categories", id), nil, nil, category)
+	return category, err
+}
+
+func (s *ProductCategoriesService) Create(ctx context.Context, category *ProductCategory) (*ProductCategory, error) {
+	created := new(ProductCategory)
+	_, err := s.client.call(ctx, http.MethodPost, "products/categories", nil, category, created)
+	return created, err
+}
+
+func (s *ProductCategoriesService) Update(ctx context.Context, id int, category *ProductCategory) (*ProductCategory, error) {
+	updated := new(ProductCategory)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "categories", id), nil, category, updated)
+	return updated, err
+}
+
+// Delete deletes a category. Terms cannot be trashed, so the request is
+// always forced.
+func (s *ProductCategoriesService) Delete(ctx context.Context, id int) (*ProductCategory, error) {
+	deleted := new(ProductCategory)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "categories", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductCategoriesService) Batch(ctx context.Context, req *BatchRequest[ProductCategory]) (*BatchResponse[ProductCategory], error) {
+	return batch(ctx, s.client, "products/categories/batch", req)
+}
+
+func (s *ProductCategoriesService) all(ctx context.Context) ([]ProductCategory, error) {
+	var categories []ProductCategory
+	err := s.ListAll(ctx, nil, func(page []ProductCategory) error {
+		categories = append(categories, page...)
+		return nil
+	})
+	return categories, err
+}
+
+// Tree fetches every category and arranges them with BuildCategoryTree.
+func (s *ProductCategoriesService) Tree(ctx context.Context) ([]*CategoryNode, error) {
+	categories, err := s.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return BuildCategoryTree(categories), nil
+}
+
+// GetByPath fetches every category and resolves a slug path such as
+// "clothing/hoodies" with FindCategoryByPath. It returns nil if no
+// category matches.
+func (s *ProductCategoriesService) GetByPath(ctx context.Context, path string) (*ProductCategory, error) {
+	categories, err := s.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return FindCategoryByPath(categories, path), nil
+}
+
+// BuildCategoryTree arranges a flat category list by parent. Categories
+// whose parent is not in the list become roots. Siblings are ordered by
+// menu order, then name.
+func BuildCategoryTree(categories []ProductCategory) []*CategoryNode {
+	nodes := make(map[int]*CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &CategoryNode{ProductCategory: category}
+	}
+	var roots []*CategoryNode
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[category.Parent]; ok && category.Parent != category.ID {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	sortCategoryNodes(roots)
+	return roots
+}
+
+func sortCategoryNodes(nodes []*CategoryNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].MenuOrder != nodes[j].MenuOrder {
+			return nodes[i].MenuOrder < nodes[j].MenuOrder
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+	for _, node := range nodes {
+		sortCategoryNodes(node.Children)
+	}
+}
+
+// FindCategoryByPath resolves a "/" separated path of slugs, starting at a
+// top level category, e.g. "clothing/hoodies". It returns nil if no
+// category matches.
+func FindCategoryByPath(categories []ProductCategory, path string) *ProductCategory {
+	nodes := BuildCategoryTree(categories)
+	var found *CategoryNode
+	for _, slug := range strings.Split(strings.Trim(path, "/"), "/") {
+		found = nil
+		for _, node := range nodes {
+			if node.Slug == slug {
+				found = node
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		nodes = found.Children
+	}
+	category := found.ProductCategory
+	return &category
+}
@@ -0,0 +1,99 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+var testCategories = []ProductCategory{
+	{ID: 1, Name: "Clothing", Slug: "clothing"},
+	{ID: 2, Name: "Hoodies", Slug: "hoodies", Parent: 1, MenuOrder: 2},
+	{ID: 3, Name: "Accessories", Slug: "accessories", Parent: 1, MenuOrder: 1},
+	{ID: 4, Name: "Zipped", Slug: "zipped", Parent: 2},
+	{ID: 5, Name: "Music", Slug: "music"},
+	{ID: 6, Name: "Orphan", Slug: "orphan", Parent: 99},
+	{ID: 7, Name: "Hoodies", Slug: "hoodies", Parent: 5},
+}
+
+func TestBuildCategoryTree(t *testing.T) {
+	roots := BuildCategoryTree(testCategories)
+	var names []string
+	for _, root := range roots {
+		names = append(names, root.Name)
+	}
+	if len(roots) != 3 || names[0] != "Clothing" || names[1] != "Music" || names[2] != "Orphan" {
+		t.Fatalf("roots = %v", names)
+	}
+	clothing := roots[0]
+	if len(clothing.Children) != 2 || clothing.Children[0].Slug != "accessories" || clothing.Children[1].Slug != "hoodies" {
+		t.Fatalf("clothing children = %+v", clothing.Children)
+	}
+	if hoodies := clothing.Children[1]; len(hoodies.Children) != 1 || hoodies.Children[0].ID != 4 {
+		t.Errorf("hoodies children = %+v", hoodies.Children)
+	}
+}
+
+func TestFindCategoryByPath(t *testing.T) {
+	cases := map[string]int{
+		"clothing/hoodies":         2,
+		"/clothing/hoodies/zipped": 4,
+		"music/hoodies":            7,
+		"clothing":                 1,
+		"hoodies":                  0,
+		"clothing/shoes":           0,
+	}
+	for path, want := range cases {
+		got := FindCategoryByPath(testCategories, path)
+		switch {
+		case want == 0 && got != nil:
+			t.Errorf("%q resolved to %d, want none", path, got.ID)
+		case want != 0 && (got == nil || got.ID != want):
+			t.Errorf("%q resolved to %v, want %d", path, got, want)
+		}
+	}
+}
+
+func TestProductCategoriesGetByPath(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/products/categories" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"id":1,"name":"Clothing","slug":"clothing","parent":0},{"id":2,"name":"Hoodies","slug":"hoodies","parent":1}]`))
+	})
+	category, err := client.ProductCategories.GetByPath(context.Background(), "clothing/hoodies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if category == nil || category.ID != 2 {
+		t.Errorf("category = %+v", category)
+	}
+}
+
+func TestProductCategoriesCRUD(t *testing.T) {
+	var method, path, force string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, force = r.Method, r.URL.Path, r.URL.Query().Get("force")
+		w.Write([]byte(`{"id":9,"name":"Clothing","slug":"clothing","parent":0,"count":36}`))
+	})
+	ctx := context.Background()
+	category, err := client.ProductCategories.Create(ctx, &ProductCategory{Name: "Clothing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/products/categories" || category.Count != 36 {
+		t.Errorf("create sent %s %s, got %+v", method, path, category)
+	}
+	if _, err := client.ProductCategories.Update(ctx, 9, &ProductCategory{Description: "All clothes"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/products/categories/9" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+	if _, err := client.ProductCategories.Delete(ctx, 9); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || force != "true" {
+		t.Errorf("delete sent %s force=%q", method, force)
+	}
+}
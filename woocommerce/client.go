@@ -1,34 +1,68 @@
 package woocommerce
 
 import (
-	"crypto/hmac"
-	"crypto/rand"
-	"crypto/sha1"
-	"crypto/sha256"
+	"bytes"
+	"context"
 	"crypto/tls"
-	"encoding/base64"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"sort"
-	"strconv"
 	"strings"
 	"time"
 )
 
 const (
 	Version       = "1.0.0"
-	UserAgent     = "WooCommerce API Client-PHP/" + Version
+	UserAgent     = "WooCommerce API Client-Go/" + Version
 	HashAlgorithm = "HMAC-SHA256"
 )
 
 type Client struct {
 	storeURL  *url.URL
+	rootURL   *url.URL
 	ck        string
 	cs        string
 	option    *Option
 	rawClient *http.Client
+	limiter   *rateLimiter
+	logger    *slog.Logger
+
+	middlewares []Middleware
+
+	Products   *ProductsService
+	Orders     *OrdersService
+	Customers  *CustomersService
+	Coupons    *CouponsService
+	Refunds    *RefundsService
+	OrderNotes *OrderNotesService
+	Inventory  *InventoryService
+
+	ProductVariations *ProductVariationsService
+	ProductCategories *ProductCategoriesService
+	ProductTags       *ProductTagsService
+	ProductAttributes *ProductAttributesService
+	ProductReviews    *ProductReviewsService
+	AttributeTerms    *AttributeTermsService
+	ShippingZones     *ShippingZonesService
+	ShippingMethods   *ShippingMethodsService
+	ShippingClasses   *ShippingClassesService
+	TaxRates          *TaxRatesService
+	TaxClasses        *TaxClassesService
+	PaymentGateways   *PaymentGatewaysService
+	Webhooks          *WebhooksService
+	Settings          *SettingsService
+	SystemStatus      *SystemStatusService
+	Reports           *ReportsService
+	Analytics         *AnalyticsService
+	Data              *DataService
+	Media             *MediaService
+	Users             *UsersService
+	Subscriptions     *SubscriptionsService
+	Memberships       *MembershipsService
+	MembershipPlans   *MembershipPlansService
+	Bookings          *BookingsService
 }
 
 func NewClient(store, ck, cs string, option *Option) (*Client, error) {
@@ -40,34 +74,155 @@ func NewClient(store, ck, cs string, option *Option) (*Client, error) {
 	if option == nil {
 		option = &Option{}
 	}
-	if option.OauthTimestamp.IsZero() {
-		option.OauthTimestamp = time.Now()
-	}
 
-	ver := "v3"
-	if option.Version != "" {
-		ver = option.Version
+	base := strings.TrimSuffix(storeURL.Path, "/")
+	rootURL := *storeURL
+	rootURL.Path = base + apiPrefix(option)
+	storeURL.Path = base + apiPath(option)
+
+	var logger *slog.Logger
+	if option.LogHandler != nil {
+		logger = slog.New(option.LogHandler)
 	}
-	path := "/wc-api/"
-	if option.API {
-		path = option.APIPrefix
+	switch option.ForceAuthMode {
+	case AuthModeAuto, AuthModeOAuth:
+	case AuthModeQueryString:
+		if storeURL.Scheme != "https" && logger != nil {
+			logger.Warn("woocommerce insecure credentials", "auth", "query string", "scheme", storeURL.Scheme)
+		}
+	case AuthModeBasicHeader:
+		if storeURL.Scheme != "https" && logger != nil {
+			logger.Warn("woocommerce insecure credentials", "auth", "basic header", "scheme", storeURL.Scheme)
+		}
+	default:
+		return nil, fmt.Errorf("Auth mode is not recognised: %d", option.ForceAuthMode)
+	}
+	if err := option.Retry.validate(); err != nil {
+		return nil, err
 	}
-	path = path + ver + "/"
-	storeURL.Path = path
 
-	rawClient := &http.Client{}
-	if !option.VerifySSL {
-		rawClient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	rawClient := option.HTTPClient
+	if rawClient == nil {
+		transport, err := newTransport(option)
+		if err != nil {
+			return nil, err
 		}
+		rawClient = &http.Client{Transport: transport}
 	}
-	return &Client{
+	c := &Client{
 		storeURL:  storeURL,
+		rootURL:   &rootURL,
 		ck:        ck,
 		cs:        cs,
 		option:    option,
 		rawClient: rawClient,
-	}, nil
+		limiter:   newRateLimiter(option.RateLimit, option.RateBurst),
+		logger:    logger,
+	}
+	c.Products = &ProductsService{client: c}
+	c.Orders = &OrdersService{client: c}
+	c.Customers = &CustomersService{client: c}
+	c.Coupons = &CouponsService{client: c}
+	c.Refunds = &RefundsService{client: c}
+	c.OrderNotes = &OrderNotesService{client: c}
+	c.Inventory = &InventoryService{client: c}
+	c.ProductVariations = &ProductVariationsService{client: c}
+	c.ProductCategories = &ProductCategoriesService{client: c}
+	c.ProductTags = &ProductTagsService{client: c}
+	c.ProductAttributes = &ProductAttributesService{client: c}
+	c.ProductReviews = &ProductReviewsService{client: c}
+	c.AttributeTerms = &AttributeTermsService{client: c}
+	c.ShippingZones = &ShippingZonesService{client: c}
+	c.ShippingMethods = &ShippingMethodsService{client: c}
+	c.ShippingClasses = &ShippingClassesService{client: c}
+	c.TaxRates = &TaxRatesService{client: c}
+	c.TaxClasses = &TaxClassesService{client: c}
+	c.PaymentGateways = &PaymentGatewaysService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
+	c.Settings = &SettingsService{client: c}
+	c.SystemStatus = &SystemStatusService{client: c}
+	c.Reports = &ReportsService{client: c}
+	c.Analytics = &AnalyticsService{client: c}
+	c.Data = &DataService{client: c}
+	c.Media = &MediaService{client: c}
+	c.Users = &UsersService{client: c}
+	c.Subscriptions = &SubscriptionsService{client: c}
+	c.Memberships = &MembershipsService{client: c}
+	c.MembershipPlans = &MembershipPlansService{client: c}
+	c.Bookings = &BookingsService{client: c}
+	return c, nil
+}
+
+// apiPath returns the path of the API root below the store URL:
+// /wp-json/wc/v3/ by default, or /wc-api/v3/ for the legacy API.
+func apiPath(option *Option) string {
+	ver := option.Version
+	if option.LegacyAPI {
+		if ver == "" {
+			ver = "v3"
+		}
+		return apiPrefix(option) + ver + "/"
+	}
+	switch ver {
+	case "":
+		ver = "wc/v3"
+	case "v1", "v2", "v3":
+		ver = "wc/" + ver
+	}
+	return apiPrefix(option) + ver + "/"
+}
+
+// apiPrefix returns the path below the store URL that namespaced routes
+// such as wc/v3 or wp/v2 live under.
+func apiPrefix(option *Option) string {
+	if option.LegacyAPI {
+		return "/wc-api/"
+	}
+	prefix := option.APIPrefix
+	if prefix == "" {
+		prefix = "/wp-json/"
+	}
+	return "/" + strings.Trim(prefix, "/") + "/"
+}
+
+// endpointURL returns the URL of endpoint. Endpoints starting with "/"
+// are relative to the API prefix rather than the versioned API root.
+func (c *Client) endpointURL(endpoint string) string {
+	if strings.HasPrefix(endpoint, "/") {
+		return c.rootURL.String() + endpoint[1:]
+	}
+	return c.storeURL.String() + endpoint
+}
+
+// newTransport builds the transport used when Option.HTTPClient is not
+// set. Requests go through Option.ProxyURL if it is set, and otherwise
+// through the proxy named by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func newTransport(option *Option) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if option.ProxyURL != "" {
+		proxyURL, err := url.Parse(option.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Proxy URL is not valid: %v", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if !option.VerifySSL {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return transport, nil
+}
+
+// authMode resolves AuthModeAuto to the mode used for the store's scheme.
+func (c *Client) authMode() AuthMode {
+	if c.option.ForceAuthMode != AuthModeAuto {
+		return c.option.ForceAuthMode
+	}
+	if c.storeURL.Scheme == "https" {
+		return AuthModeQueryString
+	}
+	return AuthModeOAuth
 }
 
 func (c *Client) basicAuth(params url.Values) string {
@@ -79,91 +234,314 @@ func (c *Client) basicAuth(params url.Values) string {
 	return params.Encode()
 }
 
-func (c *Client) oauth(method, urlStr string, params url.Values) string {
-	if params == nil {
-		params = make(url.Values)
-	}
-	params.Add("oauth_consumer_key", c.ck)
-	params.Add("oauth_timestamp", strconv.Itoa(int(c.option.OauthTimestamp.Unix())))
-	nonce := make([]byte, 16)
-	rand.Read(nonce)
-	sha1Nonce := fmt.Sprintf("%x", sha1.Sum(nonce))
-	params.Add("oauth_nonce", sha1Nonce)
-	params.Add("oauth_signature_method", HashAlgorithm)
-	var keys []string
-	for k, _ := range params {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-	var paramStrs []string
-	for _, key := range keys {
-		paramStrs = append(paramStrs, fmt.Sprintf("%s=%s", key, params.Get(key)))
-	}
-	paramStr := strings.Join(paramStrs, "&")
-	params.Add("oauth_signature", c.oauthSign(method, urlStr, paramStr))
-	return params.Encode()
+func (c *Client) request(ctx context.Context, method, endpoint string, params url.Values, data io.Reader) (*Response, error) {
+	timeout := c.option.Timeout
+	if d, ok := timeoutFrom(ctx); ok {
+		timeout = d
+	}
+	if timeout <= 0 {
+		return c.send(ctx, method, endpoint, params, data)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := c.send(ctx, method, endpoint, params, data)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.ReadCloser = &cancelOnClose{ReadCloser: resp.ReadCloser, cancel: cancel}
+	return resp, nil
+}
+
+// send makes the request, retrying it according to Option.Retry.
+func (c *Client) send(ctx context.Context, method, endpoint string, params url.Values, data io.Reader) (*Response, error) {
+	switch method {
+	case http.MethodPost, http.MethodPut:
+	case http.MethodDelete, http.MethodGet, http.MethodOptions:
+	default:
+		return nil, fmt.Errorf("Method is not recognised: %s", method)
+	}
+	var payload []byte
+	if data != nil {
+		var err error
+		if payload, err = io.ReadAll(data); err != nil {
+			return nil, err
+		}
+	}
+	if c.option.DryRun != nil {
+		if resp, ok := c.option.DryRun.capture(method, endpoint, params, payload); ok {
+			return newResponse(resp), nil
+		}
+	}
+	key, keep := c.ledgerKey(ctx, method), false
+	if key != "" {
+		if resp, ok, err := c.claim(key); ok {
+			return resp, err
+		}
+		// The claim is released unless the request succeeded or may have
+		// been applied.
+		defer func() {
+			if !keep {
+				c.option.IdempotencyLedger.Delete(key)
+			}
+		}()
+	}
+	refreshed := false
+	var maintenance time.Duration
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		if err := c.option.CircuitBreaker.allow(c.storeURL.Host); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		sent := false
+		req, err := c.newRequest(ctx, method, endpoint, params, payload)
+		if err == nil {
+			err = ctx.Err()
+		}
+		var resp *http.Response
+		if err == nil {
+			sent = true
+			resp, err = c.exchange(ctx, method, endpoint, req)
+		}
+		status := 0
+		if err == nil {
+			status = resp.StatusCode
+		}
+		c.option.CircuitBreaker.record(c.storeURL.Host, status, err)
+		if err == nil {
+			if (resp.StatusCode == http.StatusOK) || (resp.StatusCode == http.StatusCreated) {
+				if key != "" {
+					resp, err = c.settle(key, resp)
+					keep = true
+				}
+				c.observe(ctx, method, endpoint, params, attempt, status, time.Since(start), err)
+				if err != nil {
+					return nil, err
+				}
+				return newResponse(resp), nil
+			}
+			err = newAPIError(resp)
+			resp.Body.Close()
+		}
+		c.observe(ctx, method, endpoint, params, attempt, status, time.Since(start), err)
+		if key != "" && sent && status == 0 {
+			keep = true
+			return nil, &InDoubtError{Key: key, Err: err}
+		}
+		if r, ok := c.option.Auth.(Refresher); ok && status == http.StatusUnauthorized && !refreshed {
+			refreshed = true
+			if err := r.Refresh(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if pause, ok := c.maintenancePause(err, maintenance); ok {
+			c.logMaintenance(ctx, method, endpoint, pause, maintenance)
+			if err := sleep(ctx, pause); err != nil {
+				return nil, err
+			}
+			maintenance += pause
+			attempt--
+			continue
+		}
+		delay, ok := c.option.Retry.next(attempt, err)
+		if !ok {
+			return nil, err
+		}
+		c.logRetry(ctx, method, endpoint, attempt, delay)
+		if c.option.Metrics != nil {
+			c.option.Metrics.ObserveRetry(method, routeOf(endpoint))
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (c *Client) oauthSign(method, endpoint, params string) string {
-	signingKey := c.cs
-	if c.option.Version != "v1" || c.option.Version != "v2" {
-		signingKey = signingKey + "&"
+func (c *Client) observe(ctx context.Context, method, endpoint string, params url.Values, attempt, status int, elapsed time.Duration, err error) {
+	c.logAttempt(ctx, method, endpoint, params, attempt, status, elapsed, err)
+	if c.option.Metrics != nil {
+		c.option.Metrics.ObserveRequest(method, routeOf(endpoint), status, elapsed)
 	}
+}
 
-	a := strings.Join([]string{method, url.QueryEscape(endpoint), url.QueryEscape(params)}, "&")
-	mac := hmac.New(sha256.New, []byte(signingKey))
-	mac.Write([]byte(a))
-	signatureBytes := mac.Sum(nil)
-	return base64.StdEncoding.EncodeToString(signatureBytes)
+func (c *Client) do(ctx context.Context, method, endpoint string, params url.Values, payload []byte, opts ...RequestOption) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, endpoint, params, payload, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.exchange(ctx, method, endpoint, req)
 }
 
-func (c *Client) request(method, endpoint string, params url.Values, data io.Reader) (io.ReadCloser, error) {
-	urlstr := c.storeURL.String() + endpoint
+// newRequest builds an authenticated request. Nothing has been sent when
+// it fails.
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, params url.Values, payload []byte, opts ...RequestOption) (*http.Request, error) {
+	urlstr := c.endpointURL(endpoint)
 
-	body := data
-	if c.storeURL.Scheme == "https" {
-		urlstr += "?" + c.basicAuth(params)
-	} else {
-		urlstr += "?" + c.oauth(method, urlstr, params)
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = append([]string(nil), v...)
 	}
-	fmt.Println(body)
-	switch method {
-	case http.MethodPost, http.MethodPut:
-	case http.MethodDelete, http.MethodGet, http.MethodOptions:
+	if c.option.Language != "" {
+		query.Set("lang", c.option.Language)
+	}
+	if fields := fieldsFrom(ctx); fields != "" && method == http.MethodGet {
+		query.Set("_fields", fields)
+	}
+	switch {
+	case c.option.Auth != nil, c.authMode() == AuthModeBasicHeader:
+		if len(query) > 0 {
+			urlstr += "?" + query.Encode()
+		}
+	case c.authMode() == AuthModeQueryString:
+		urlstr += "?" + c.basicAuth(query)
 	default:
-		return nil, fmt.Errorf("Method is not recognised: %s", method)
+		urlstr += "?" + c.oauth(method, urlstr, query)
 	}
-	req, err := http.NewRequest(method, urlstr, body)
-	req.Header.Set("Content-Type", "application/json")
+	compressed := false
+	if c.option.CompressRequests && len(payload) >= minCompressSize {
+		var err error
+		if payload, err = compressPayload(payload); err != nil {
+			return nil, err
+		}
+		compressed = true
+	}
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(withRoute(ctx, endpoint), method, urlstr, body)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.rawClient.Do(req)
+	if c.option.Auth != nil {
+		if err := c.option.Auth.Authenticate(ctx, req); err != nil {
+			return nil, err
+		}
+	} else if c.authMode() == AuthModeBasicHeader {
+		req.SetBasicAuth(c.ck, c.cs)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
+	if compressed {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	userAgent := UserAgent
+	if c.option.UserAgent != "" {
+		userAgent = c.option.UserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if key := idempotencyKeyFrom(ctx); key != "" && method == http.MethodPost {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if c.option.Language != "" {
+		req.Header.Set("Accept-Language", c.option.Language)
+	}
+	for k, v := range c.option.Header {
+		req.Header[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+	}
+	for k, v := range headerFrom(ctx) {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return req, nil
+}
+
+// exchange sends req and returns the decoded response.
+func (c *Client) exchange(ctx context.Context, method, endpoint string, req *http.Request) (*http.Response, error) {
+	cacheKey, cached := c.conditional(req)
+	requested := time.Now()
+	resp, err := c.roundTrip(req)
 	if err != nil {
 		return nil, err
 	}
-	if (resp.StatusCode != http.StatusOK) && (resp.StatusCode != http.StatusCreated) {
-		return nil, fmt.Errorf("Request failed: %s", resp.Status)
+	if err := decodeBody(resp); err != nil {
+		return nil, err
+	}
+	c.logDeprecations(ctx, method, endpoint, resp.Header)
+	if cacheKey != "" {
+		if err := c.revalidate(ctx, cacheKey, cached, resp, requested); err != nil {
+			return nil, err
+		}
+	}
+	if c.option.Recorder != nil {
+		if err := recordResponse(c.option.Recorder, req, resp); err != nil {
+			return nil, err
+		}
 	}
-	return resp.Body, nil
+	return resp, nil
 }
 
-func (c *Client) Post(endpoint string, data io.Reader) (io.ReadCloser, error) {
-	return c.request("POST", endpoint, nil, data)
+func (c *Client) Post(ctx context.Context, endpoint string, data io.Reader) (*Response, error) {
+	return c.request(ctx, "POST", endpoint, nil, data)
 }
 
-func (c *Client) Put(endpoint string, data io.Reader) (io.ReadCloser, error) {
-	return c.request("PUT", endpoint, nil, data)
+func (c *Client) Put(ctx context.Context, endpoint string, data io.Reader) (*Response, error) {
+	return c.request(ctx, "PUT", endpoint, nil, data)
 }
 
-func (c *Client) Get(endpoint string, params url.Values) (io.ReadCloser, error) {
-	return c.request("GET", endpoint, params, nil)
+func (c *Client) Get(ctx context.Context, endpoint string, params url.Values) (*Response, error) {
+	return c.request(ctx, "GET", endpoint, params, nil)
 }
 
-func (c *Client) Delete(endpoint string, params url.Values) (io.ReadCloser, error) {
-	return c.request("POST", endpoint, params, nil)
+// Delete deletes the resource at endpoint and returns its last
+// representation. Resources that support the trash are moved there unless
+// force is true; others require force.
+func (c *Client) Delete(ctx context.Context, endpoint string, force bool, params url.Values) (*Response, error) {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	if force {
+		query.Set("force", "true")
+	}
+	return c.request(ctx, "DELETE", endpoint, query, nil)
+}
+
+func (c *Client) Options(ctx context.Context, endpoint string) (*Response, error) {
+	return c.request(ctx, "OPTIONS", endpoint, nil, nil)
 }
 
-func (c *Client) Options(endpoint string) (io.ReadCloser, error) {
-	return c.request("OPTIONS", endpoint, nil, nil)
+// RequestOption modifies a request sent with Do after it is signed, just
+// before it is sent.
+type RequestOption func(req *http.Request)
+
+// Do sends a request and returns the response whatever its status, for
+// endpoints the typed services do not cover. Authentication, signing,
+// headers, rate limiting and Option.DryRun are applied as for other calls,
+// but retries and idempotency records are not. endpoint is relative to the
+// API root, or to the API prefix if it starts with "/", e.g.
+// "/wc-bookings/v1/bookings". The caller must close the response body.
+func (c *Client) Do(ctx context.Context, method, endpoint string, params url.Values, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	if c.option.DryRun != nil {
+		if resp, ok := c.option.DryRun.capture(method, endpoint, params, payload); ok {
+			return resp, nil
+		}
+	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if err := c.option.CircuitBreaker.allow(c.storeURL.Host); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := c.do(ctx, method, endpoint, params, payload, opts...)
+	status := 0
+	if err == nil {
+		status = resp.StatusCode
+	}
+	c.option.CircuitBreaker.record(c.storeURL.Host, status, err)
+	c.observe(ctx, method, endpoint, params, 1, status, time.Since(start), err)
+	return resp, err
 }
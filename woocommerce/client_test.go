@@ -1,9 +1,18 @@
 package woocommerce
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -21,7 +30,7 @@ func TestClient(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	body, err := client.Get("orders", nil)
+	body, err := client.Get(context.Background(), "orders", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,3 +48,287 @@ func TestClient(t *testing.T) {
 		t.Fatal("Wrong count of orders")
 	}
 }
+
+func newTestServer(t *testing.T, tls bool, handler http.HandlerFunc) *httptest.Server {
+	var srv *httptest.Server
+	if tls {
+		srv = httptest.NewTLSServer(handler)
+	} else {
+		srv = httptest.NewServer(handler)
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestForceAuthMode(t *testing.T) {
+	cases := []struct {
+		name  string
+		tls   bool
+		mode  AuthMode
+		query bool
+	}{
+		{"auto http", false, AuthModeAuto, false},
+		{"auto https", true, AuthModeAuto, true},
+		{"oauth over https", true, AuthModeOAuth, false},
+		{"query over http", false, AuthModeQueryString, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got url.Values
+			srv := newTestServer(t, tc.tls, func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.Query()
+				w.Write([]byte("{}"))
+			})
+			client, err := NewClient(srv.URL, "ck", "cs", &Option{ForceAuthMode: tc.mode})
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := client.Get(context.Background(), "orders", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body.Close()
+			if query := got.Get("consumer_key") == "ck"; query != tc.query {
+				t.Errorf("query string auth = %v, want %v", query, tc.query)
+			}
+			if oauth := got.Get("oauth_signature") != ""; oauth == tc.query {
+				t.Errorf("oauth = %v, want %v", oauth, !tc.query)
+			}
+		})
+	}
+}
+
+func TestBasicHeaderAuth(t *testing.T) {
+	var got *http.Request
+	srv := newTestServer(t, true, func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Write([]byte("[]"))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{ForceAuthMode: AuthModeBasicHeader})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := client.Get(context.Background(), "orders", url.Values{"status": {"processing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if user, pass, ok := got.BasicAuth(); !ok || user != "ck" || pass != "cs" {
+		t.Errorf("basic auth = %q, %q, %v", user, pass, ok)
+	}
+	if got.URL.RawQuery != "status=processing" {
+		t.Errorf("query = %s", got.URL.RawQuery)
+	}
+}
+
+func TestInsecureAuthModeLogged(t *testing.T) {
+	var logs bytes.Buffer
+	option := &Option{ForceAuthMode: AuthModeQueryString, LogHandler: slog.NewTextHandler(&logs, nil)}
+	if _, err := NewClient("http://example.com", "ck", "cs", option); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "insecure credentials") || !strings.Contains(logs.String(), "scheme=http") {
+		t.Errorf("logs = %q", logs.String())
+	}
+}
+
+func TestForceAuthModeInvalid(t *testing.T) {
+	if _, err := NewClient("https://example.com", "ck", "cs", &Option{ForceAuthMode: AuthMode(42)}); err == nil {
+		t.Fatal("expected error for unknown auth mode")
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	var lang, header string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		lang = r.URL.Query().Get("lang")
+		header = r.Header.Get("Accept-Language")
+		w.Write([]byte("{}"))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Language: "fr"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := client.Get(context.Background(), "products", url.Values{"per_page": {"5"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if lang != "fr" {
+		t.Errorf("lang = %q, want fr", lang)
+	}
+	if header != "fr" {
+		t.Errorf("Accept-Language = %q, want fr", header)
+	}
+}
+
+func TestRequestContextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-block:
+		case <-r.Context().Done():
+		}
+	})
+	defer close(block)
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Get(ctx, "orders", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestCustomHTTPClient(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("X-Instrumented")))
+	})
+	var seen *http.Request
+	httpClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		seen = req
+		req.Header.Set("X-Instrumented", "yes")
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{HTTPClient: httpClient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "orders", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(resp)
+	resp.Close()
+	if string(got) != "yes" {
+		t.Errorf("body = %q, want yes", got)
+	}
+	if seen == nil || seen.URL.Query().Get("oauth_signature") == "" {
+		t.Error("request through custom client was not signed")
+	}
+}
+
+func TestProxyURL(t *testing.T) {
+	var host string
+	proxy := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		host = r.URL.Host
+		w.Write([]byte(`{}`))
+	})
+	client, err := NewClient("http://store.example.com", "ck", "cs", &Option{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "orders", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+	if host != "store.example.com" {
+		t.Errorf("proxy saw host %q", host)
+	}
+
+	if _, err := NewClient("http://store.example.com", "ck", "cs", &Option{ProxyURL: "://proxy"}); err == nil {
+		t.Error("expected error for invalid proxy URL")
+	}
+	client, err = NewClient("http://store.example.com", "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transport, ok := client.rawClient.Transport.(*http.Transport); !ok || transport.Proxy == nil {
+		t.Error("default transport ignores proxy environment variables")
+	}
+}
+
+func TestClientDelete(t *testing.T) {
+	var method string
+	var query url.Values
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, query = r.Method, r.URL.Query()
+		w.Write([]byte(`{"id":794,"status":"trash"}`))
+	})
+	body, err := client.Delete(context.Background(), "products/794", true, url.Values{"reassign": {"1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	var deleted Product
+	if err := json.NewDecoder(body).Decode(&deleted); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || query.Get("force") != "true" || query.Get("reassign") != "1" {
+		t.Errorf("sent %s with %v", method, query)
+	}
+	if deleted.ID != 794 {
+		t.Errorf("deleted = %+v", deleted)
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	var got *http.Request
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte(`{"code":"teapot"}`))
+	})
+	client, err := NewClient(srv.URL+"/shop", "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(context.Background(), "PATCH", "/wc-bookings/v1/bookings/7", url.Values{"a": {"1"}}, nil,
+		func(req *http.Request) { req.Header.Set("X-Plugin", "yes") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusTeapot {
+		t.Errorf("status = %d", resp.StatusCode)
+	}
+	if got.Method != "PATCH" || got.URL.Path != "/shop/wp-json/wc-bookings/v1/bookings/7" || got.Header.Get("X-Plugin") != "yes" {
+		t.Errorf("sent %s %s %v", got.Method, got.URL.Path, got.Header)
+	}
+	if q := got.URL.Query(); q.Get("a") != "1" || q.Get("oauth_signature") == "" {
+		t.Errorf("query = %v", q)
+	}
+
+	if _, err := client.Do(context.Background(), http.MethodGet, "orders", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/shop/wp-json/wc/v3/orders" {
+		t.Errorf("path = %s", got.URL.Path)
+	}
+}
+
+func TestAPIPath(t *testing.T) {
+	for _, tc := range []struct {
+		store  string
+		option *Option
+		want   string
+	}{
+		{"https://example.com", nil, "/wp-json/wc/v3/"},
+		{"https://example.com/shop/", nil, "/shop/wp-json/wc/v3/"},
+		{"https://example.com", &Option{Version: "v2"}, "/wp-json/wc/v2/"},
+		{"https://example.com", &Option{Version: "wc-bookings/v1"}, "/wp-json/wc-bookings/v1/"},
+		{"https://example.com", &Option{APIPrefix: "api"}, "/api/wc/v3/"},
+		{"https://example.com", &Option{LegacyAPI: true}, "/wc-api/v3/"},
+		{"https://example.com", &Option{LegacyAPI: true, Version: "v2"}, "/wc-api/v2/"},
+	} {
+		client, err := NewClient(tc.store, "ck", "cs", tc.option)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.storeURL.Path != tc.want {
+			t.Errorf("%s %+v: path = %s, want %s", tc.store, tc.option, client.storeURL.Path, tc.want)
+		}
+	}
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	srv := newTestServer(t, false, handler)
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
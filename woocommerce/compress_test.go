@@ -0,0 +1,79 @@
+package woocommerce
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func gzipHandler(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(status)
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(body))
+		zw.Close()
+	}
+}
+
+func TestGzipResponse(t *testing.T) {
+	client := newTestClient(t, gzipHandler(http.StatusOK, `{"id":794,"name":"Premium Quality"}`))
+	product, err := client.Products.Get(context.Background(), 794)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.Name != "Premium Quality" {
+		t.Errorf("product = %+v", product)
+	}
+
+	client = newTestClient(t, gzipHandler(http.StatusNotFound, `{"code":"woocommerce_rest_product_invalid_id","message":"Invalid ID."}`))
+	var apiErr *APIError
+	if _, err := client.Products.Get(context.Background(), 1); !errors.As(err, &apiErr) || apiErr.Code != "woocommerce_rest_product_invalid_id" {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestCompressRequests(t *testing.T) {
+	var encoding, body string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		encoding = r.Header.Get("Content-Encoding")
+		var reader io.Reader = r.Body
+		if encoding == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reader = zr
+		}
+		data, _ := io.ReadAll(reader)
+		body = string(data)
+		w.Write([]byte(`{}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{CompressRequests: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	description := strings.Repeat("Lorem ipsum dolor sit amet. ", 100)
+	if _, err := client.Products.Create(ctx, &Product{Description: description}); err != nil {
+		t.Fatal(err)
+	}
+	if encoding != "gzip" || !strings.Contains(body, description) {
+		t.Errorf("large body: encoding = %q, body = %.40q", encoding, body)
+	}
+	if _, err := client.Products.Create(ctx, &Product{Name: "Short"}); err != nil {
+		t.Fatal(err)
+	}
+	if encoding != "" || body != `{"name":"Short"}` {
+		t.Errorf("small body: encoding = %q, body = %q", encoding, body)
+	}
+}
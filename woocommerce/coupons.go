@@ -0,0 +1,169 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CouponsService manages /coupons. Fields left at their zero value are
+// omitted from create and update requests.
+type CouponsService service
+
+type Coupon struct {
+	ID                        int      `json:"id,omitempty"`
+	Code                      string   `json:"code,omitempty"`
+	Amount                    Decimal  `json:"amount,omitzero"`
+	DateCreated               WCTime   `json:"date_created,omitzero"`
+	DateCreatedGMT            WCTime   `json:"date_created_gmt,omitzero"`
+	DateModified              WCTime   `json:"date_modified,omitzero"`
+	DateModifiedGMT           WCTime   `json:"date_modified_gmt,omitzero"`
+	DiscountType              string   `json:"discount_type,omitempty"`
+	Description               string   `json:"description,omitempty"`
+	DateExpires               WCTime   `json:"date_expires,omitzero"`
+	DateExpiresGMT            WCTime   `json:"date_expires_gmt,omitzero"`
+	UsageCount                int      `json:"usage_count,omitempty"`
+	IndividualUse             *bool    `json:"individual_use,omitempty"`
+	ProductIDs                []int    `json:"product_ids,omitempty"`
+	ExcludedProductIDs        []int    `json:"excluded_product_ids,omitempty"`
+	UsageLimit                *int     `json:"usage_limit,omitempty"`
+	UsageLimitPerUser         *int     `json:"usage_limit_per_user,omitempty"`
+	LimitUsageToXItems        *int     `json:"limit_usage_to_x_items,omitempty"`
+	FreeShipping              *bool    `json:"free_shipping,omitempty"`
+	ProductCategories         []int    `json:"product_categories,omitempty"`
+	ExcludedProductCategories []int    `json:"excluded_product_categories,omitempty"`
+	ExcludeSaleItems          *bool    `json:"exclude_sale_items,omitempty"`
+	MinimumAmount             Decimal  `json:"minimum_amount,omitzero"`
+	MaximumAmount             Decimal  `json:"maximum_amount,omitzero"`
+	EmailRestrictions         []string `json:"email_restrictions,omitempty"`
+	UsedBy                    []string `json:"used_by,omitempty"`
+	MetaData                  Meta     `json:"meta_data,omitempty"`
+}
+
+// Coupon discount types.
+const (
+	DiscountTypePercent      = "percent"
+	DiscountTypeFixedCart    = "fixed_cart"
+	DiscountTypeFixedProduct = "fixed_product"
+)
+
+func (s *CouponsService) List(ctx context.Context, params *ListParams) ([]Coupon, *Response, error) {
+	var coupons []Coupon
+	resp, err := s.client.list(ctx, "coupons", params, &coupons)
+	return coupons, resp, err
+}
+
+func (s *CouponsService) Get(ctx context.Context, id int) (*Coupon, error) {
+	coupon := new(Coupon)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("coupons", id), nil, nil, coupon)
+	return coupon, err
+}
+
+func (s *CouponsService) Create(ctx context.Context, coupon *Coupon) (*Coupon, error) {
+	created := new(Coupon)
+	_, err := s.client.call(ctx, http.MethodPost, "coupons", nil, coupon, created)
+	return created, err
+}
+
+func (s *CouponsService) Update(ctx context.Context, id int, coupon *Coupon) (*Coupon, error) {
+	updated := new(Coupon)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("coupons", id), nil, coupon, updated)
+	return updated, err
+}
+
+// Delete moves a coupon to the trash, or deletes it permanently when force
+// is true. It returns the deleted coupon.
+func (s *CouponsService) Delete(ctx context.Context, id int, force bool) (*Coupon, error) {
+	deleted := new(Coupon)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("coupons", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *CouponsService) Batch(ctx context.Context, req *BatchRequest[Coupon]) (*BatchResponse[Coupon], error) {
+	return batch(ctx, s.client, "coupons/batch", req)
+}
+
+// ApplicableTo checks the coupon's restrictions against order without
+// asking the store: expiry at now, usage limit, minimum and maximum
+// amount, product and category inclusion and exclusion, and individual
+// use with the order's other coupon lines. The amounts are compared with
+// the sum of the line subtotals, before discounts and tax. Categories are
+// read from LineItem.CategoryIDs, which WooCommerce does not send; set
+// them when the coupon has category restrictions. Sale items are not
+// checked. When the coupon does not apply it returns false and the
+// reason.
+func (c *Coupon) ApplicableTo(order *Order, now time.Time) (bool, string) {
+	expires := c.DateExpiresGMT.Time
+	if expires.IsZero() {
+		expires = c.DateExpires.Time
+	}
+	if !expires.IsZero() && !now.Before(expires) {
+		return false, "coupon has expired"
+	}
+	if c.UsageLimit != nil && *c.UsageLimit > 0 && c.UsageCount >= *c.UsageLimit {
+		return false, "coupon usage limit has been reached"
+	}
+	if isTrue(c.IndividualUse) {
+		for _, line := range order.CouponLines {
+			if !strings.EqualFold(line.Code, c.Code) {
+				return false, fmt.Sprintf("coupon cannot be used with coupon %q", line.Code)
+			}
+		}
+	}
+
+	var subtotal Decimal
+	for _, item := range order.LineItems {
+		subtotal = subtotal.Add(item.Subtotal)
+	}
+	if !c.MinimumAmount.IsZero() && subtotal.Cmp(c.MinimumAmount) < 0 {
+		return false, fmt.Sprintf("order subtotal %s is below the minimum of %s", subtotal, c.MinimumAmount)
+	}
+	if !c.MaximumAmount.IsZero() && subtotal.Cmp(c.MaximumAmount) > 0 {
+		return false, fmt.Sprintf("order subtotal %s is above the maximum of %s", subtotal, c.MaximumAmount)
+	}
+
+	if len(c.ProductIDs) > 0 && !slices.ContainsFunc(order.LineItems, func(item LineItem) bool {
+		return item.in(c.ProductIDs)
+	}) {
+		return false, "order has none of the coupon's products"
+	}
+	if len(c.ProductCategories) > 0 && !slices.ContainsFunc(order.LineItems, func(item LineItem) bool {
+		return item.inCategory(c.ProductCategories)
+	}) {
+		return false, "order has no products in the coupon's categories"
+	}
+	eligible := false
+	for _, item := range order.LineItems {
+		excluded := item.in(c.ExcludedProductIDs) || item.inCategory(c.ExcludedProductCategories)
+		if excluded && c.isCartType() {
+			// Fixed cart coupons apply to the whole order, so a single
+			// excluded item rules them out; percent and fixed product
+			// coupons skip it.
+			return false, fmt.Sprintf("coupon does not apply to product %d", item.ProductID)
+		}
+		eligible = eligible || !excluded
+	}
+	if !eligible && len(order.LineItems) > 0 {
+		return false, "coupon does not apply to any product in the order"
+	}
+	return true, ""
+}
+
+// isCartType reports whether the coupon discounts the cart as a whole,
+// fixed_cart being the default discount type.
+func (c *Coupon) isCartType() bool {
+	return c.DiscountType == DiscountTypeFixedCart || c.DiscountType == ""
+}
+
+func (l LineItem) in(productIDs []int) bool {
+	return slices.Contains(productIDs, l.ProductID) || l.VariationID != 0 && slices.Contains(productIDs, l.VariationID)
+}
+
+func (l LineItem) inCategory(categoryIDs []int) bool {
+	return slices.ContainsFunc(l.CategoryIDs, func(id int) bool {
+		return slices.Contains(categoryIDs, id)
+	})
+}
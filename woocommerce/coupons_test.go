@@ -0,0 +1,140 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const couponJSON = `{
+	"id": 719,
+	"code": "10off",
+	"amount": "10.00",
+	"discount_type": "percent",
+	"description": "",
+	"date_expires": "2024-12-31T00:00:00",
+	"usage_count": 3,
+	"individual_use": true,
+	"product_ids": [],
+	"excluded_product_ids": [31],
+	"usage_limit": 100,
+	"usage_limit_per_user": null,
+	"free_shipping": false,
+	"product_categories": [9],
+	"exclude_sale_items": true,
+	"minimum_amount": "100.00",
+	"maximum_amount": "0.00",
+	"email_restrictions": ["*@example.com"],
+	"used_by": ["1"]
+}`
+
+func TestCouponsGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(couponJSON))
+	})
+	coupon, err := client.Coupons.Get(context.Background(), 719)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coupon.Code != "10off" || coupon.DiscountType != DiscountTypePercent || !isTrue(coupon.IndividualUse) {
+		t.Errorf("unexpected coupon: %+v", coupon)
+	}
+	if coupon.UsageLimit == nil || *coupon.UsageLimit != 100 || coupon.UsageLimitPerUser != nil {
+		t.Errorf("usage limits = %v, %v", coupon.UsageLimit, coupon.UsageLimitPerUser)
+	}
+	if len(coupon.ExcludedProductIDs) != 1 || coupon.ProductCategories[0] != 9 || coupon.EmailRestrictions[0] != "*@example.com" {
+		t.Errorf("restrictions = %+v", coupon)
+	}
+}
+
+func TestCouponsCRUD(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		if r.Method == http.MethodGet {
+			w.Write([]byte("[" + couponJSON + "]"))
+			return
+		}
+		w.Write([]byte(couponJSON))
+	})
+	ctx := context.Background()
+	limit := 1
+	if _, err := client.Coupons.Create(ctx, &Coupon{Code: "10off", DiscountType: DiscountTypePercent, Amount: NewDecimal(10, 0), UsageLimitPerUser: &limit}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["code"] != "10off" || body["usage_limit_per_user"] != 1.0 {
+		t.Errorf("create sent %s %v", method, body)
+	}
+	if _, err := client.Coupons.Update(ctx, 719, &Coupon{Amount: NewDecimal(15, 0)}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/coupons/719" || body["amount"] != "15" {
+		t.Errorf("update sent %s %s %v", method, path, body)
+	}
+	if _, err := client.Coupons.Delete(ctx, 719, true); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete {
+		t.Errorf("delete sent %s", method)
+	}
+	coupons, _, err := client.Coupons.List(ctx, NewListParams().Search("10off"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coupons) != 1 || coupons[0].ID != 719 {
+		t.Errorf("coupons = %+v", coupons)
+	}
+}
+
+func TestCouponApplicableTo(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	limit := 5
+	order := &Order{
+		LineItems: []LineItem{
+			{ProductID: 93, Subtotal: NewDecimal(3000, 2), CategoryIDs: []int{9}},
+			{ProductID: 22, VariationID: 23, Subtotal: NewDecimal(1500, 2), CategoryIDs: []int{14}},
+		},
+		CouponLines: []CouponLine{{Code: "summer"}},
+	}
+	tests := []struct {
+		name   string
+		coupon Coupon
+		ok     bool
+		reason string
+	}{
+		{"unrestricted", Coupon{Code: "summer"}, true, ""},
+		{"not expired", Coupon{DateExpiresGMT: WCTime{Time: now.Add(time.Hour)}}, true, ""},
+		{"expired", Coupon{DateExpiresGMT: WCTime{Time: now}}, false, "expired"},
+		{"used up", Coupon{UsageLimit: &limit, UsageCount: 5}, false, "usage limit"},
+		{"minimum met", Coupon{MinimumAmount: NewDecimal(45, 0)}, true, ""},
+		{"below minimum", Coupon{MinimumAmount: NewDecimal(50, 0)}, false, "below the minimum of 50"},
+		{"above maximum", Coupon{MaximumAmount: NewDecimal(40, 0)}, false, "above the maximum of 40"},
+		{"product included", Coupon{ProductIDs: []int{23}}, true, ""},
+		{"no product included", Coupon{ProductIDs: []int{7}}, false, "none of the coupon's products"},
+		{"category included", Coupon{ProductCategories: []int{14, 15}}, true, ""},
+		{"no category included", Coupon{ProductCategories: []int{15}}, false, "categories"},
+		{"cart coupon excluded product", Coupon{DiscountType: DiscountTypeFixedCart, ExcludedProductIDs: []int{93}}, false, "product 93"},
+		{"default type excluded product", Coupon{ExcludedProductIDs: []int{22}}, false, "product 22"},
+		{"percent coupon skips excluded product", Coupon{DiscountType: DiscountTypePercent, ExcludedProductIDs: []int{93}}, true, ""},
+		{"percent coupon all excluded", Coupon{DiscountType: DiscountTypePercent, ExcludedProductIDs: []int{93, 23}}, false, "any product"},
+		{"cart coupon excluded category", Coupon{DiscountType: DiscountTypeFixedCart, ExcludedProductCategories: []int{14}}, false, "product 22"},
+		{"product coupon partly excluded", Coupon{DiscountType: DiscountTypeFixedProduct, ExcludedProductIDs: []int{93}}, true, ""},
+		{"product coupon all excluded", Coupon{DiscountType: DiscountTypeFixedProduct, ExcludedProductCategories: []int{9, 14}}, false, "any product"},
+		{"individual use alone", Coupon{Code: "SUMMER", IndividualUse: Bool(true)}, true, ""},
+		{"individual use with others", Coupon{Code: "10off", IndividualUse: Bool(true)}, false, `coupon "summer"`},
+	}
+	for _, tt := range tests {
+		ok, reason := tt.coupon.ApplicableTo(order, now)
+		if ok != tt.ok || !strings.Contains(reason, tt.reason) || ok && reason != "" {
+			t.Errorf("%s: ApplicableTo() = %v, %q, want %v, %q", tt.name, ok, reason, tt.ok, tt.reason)
+		}
+	}
+}
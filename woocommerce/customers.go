@@ -0,0 +1,112 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CustomersService manages /customers. Fields left at their zero value are
+// omitted from create and update requests.
+type CustomersService service
+
+type Customer struct {
+	ID               int      `json:"id,omitempty"`
+	DateCreated      WCTime   `json:"date_created,omitzero"`
+	DateCreatedGMT   WCTime   `json:"date_created_gmt,omitzero"`
+	DateModified     WCTime   `json:"date_modified,omitzero"`
+	DateModifiedGMT  WCTime   `json:"date_modified_gmt,omitzero"`
+	Email            string   `json:"email,omitempty"`
+	FirstName        string   `json:"first_name,omitempty"`
+	LastName         string   `json:"last_name,omitempty"`
+	Role             string   `json:"role,omitempty"`
+	Username         string   `json:"username,omitempty"`
+	Password         string   `json:"password,omitempty"`
+	Billing          *Address `json:"billing,omitempty"`
+	Shipping         *Address `json:"shipping,omitempty"`
+	IsPayingCustomer bool     `json:"is_paying_customer,omitempty"`
+	AvatarURL        string   `json:"avatar_url,omitempty"`
+	MetaData         Meta     `json:"meta_data,omitempty"`
+}
+
+func (s *CustomersService) List(ctx context.Context, params *ListParams) ([]Customer, *Response, error) {
+	var customers []Customer
+	resp, err := s.client.list(ctx, "customers", params, &customers)
+	return customers, resp, err
+}
+
+// ListAll calls fn with every page of customers matching params.
+func (s *CustomersService) ListAll(ctx context.Context, params *ListParams, fn func([]Customer) error) error {
+	return listAll(ctx, s.client, "customers", params, fn)
+}
+
+// Stream calls fn with every customer matching params, decoding them one at a
+// time as they arrive; see Client.Stream.
+func (s *CustomersService) Stream(ctx context.Context, params *ListParams, fn func(*Customer) error) error {
+	return streamList(ctx, s.client, "customers", params, fn)
+}
+
+// BulkFetch calls fn with every page of customers matching params, fetching
+// pages concurrently; see Client.BulkFetch.
+func (s *CustomersService) BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Customer) error) error {
+	return bulkFetch(ctx, s.client, "customers", params, opts, fn)
+}
+
+func (s *CustomersService) Get(ctx context.Context, id int) (*Customer, error) {
+	customer := new(Customer)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("customers", id), nil, nil, customer)
+	return customer, err
+}
+
+// GetByEmail looks a customer up by email address. It returns an
+// *APIError with status 404 when no customer has that address.
+func (s *CustomersService) GetByEmail(ctx context.Context, email string) (*Customer, error) {
+	customers, _, err := s.List(ctx, NewListParams().Set("email", email).Set("role", "all"))
+	if err != nil {
+		return nil, err
+	}
+	if len(customers) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Code:       "woocommerce_rest_customer_not_found",
+			Message:    fmt.Sprintf("No customer with email %s.", email),
+			Data:       APIErrorData{Status: http.StatusNotFound},
+		}
+	}
+	return &customers[0], nil
+}
+
+func (s *CustomersService) Create(ctx context.Context, customer *Customer) (*Customer, error) {
+	created := new(Customer)
+	_, err := s.client.call(ctx, http.MethodPost, "customers", nil, customer, created)
+	return created, err
+}
+
+func (s *CustomersService) Update(ctx context.Context, id int, customer *Customer) (*Customer, error) {
+	updated := new(Customer)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("customers", id), nil, customer, updated)
+	return updated, err
+}
+
+// Patch sends only the fields in changes, keyed by their JSON names, e.g.
+// {"first_name": "James"}, leaving every other field as it is. WooCommerce
+// replaces billing and shipping as a whole, so when included they must
+// hold the complete address, not just the parts that change.
+func (s *CustomersService) Patch(ctx context.Context, id int, changes map[string]interface{}) (*Customer, error) {
+	updated := new(Customer)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("customers", id), nil, changes, updated)
+	return updated, err
+}
+
+// Delete deletes a customer. Customers cannot be trashed, so WooCommerce
+// requires force to be true.
+func (s *CustomersService) Delete(ctx context.Context, id int, force bool) (*Customer, error) {
+	deleted := new(Customer)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("customers", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *CustomersService) Batch(ctx context.Context, req *BatchRequest[Customer]) (*BatchResponse[Customer], error) {
+	return batch(ctx, s.client, "customers/batch", req)
+}
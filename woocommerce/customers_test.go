@@ -0,0 +1,124 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const customerJSON = `{
+	"id": 25,
+	"email": "john.doe@example.com",
+	"first_name": "John",
+	"last_name": "Doe",
+	"role": "customer",
+	"username": "john.doe",
+	"billing": {"first_name": "John", "last_name": "Doe", "city": "San Francisco", "country": "US", "email": "john.doe@example.com", "phone": "(555) 555-5555"},
+	"shipping": {"first_name": "John", "last_name": "Doe", "city": "San Francisco", "country": "US"},
+	"is_paying_customer": true,
+	"meta_data": [{"id": 3, "key": "crm_id", "value": 991}]
+}`
+
+func TestCustomersGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/customers/25" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(customerJSON))
+	})
+	customer, err := client.Customers.Get(context.Background(), 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Email != "john.doe@example.com" || !customer.IsPayingCustomer {
+		t.Errorf("unexpected customer: %+v", customer)
+	}
+	if customer.Billing.Phone != "(555) 555-5555" || customer.Shipping.City != "San Francisco" {
+		t.Errorf("addresses = %+v / %+v", customer.Billing, customer.Shipping)
+	}
+	if len(customer.MetaData) != 1 || customer.MetaData[0].Value != 991.0 {
+		t.Errorf("MetaData = %+v", customer.MetaData)
+	}
+}
+
+func TestCustomersGetByEmail(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/customers" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("email") == "john.doe@example.com" {
+			w.Write([]byte("[" + customerJSON + "]"))
+			return
+		}
+		w.Write([]byte("[]"))
+	})
+	customer, err := client.Customers.GetByEmail(context.Background(), "john.doe@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.ID != 25 {
+		t.Errorf("ID = %d, want 25", customer.ID)
+	}
+	_, err = client.Customers.GetByEmail(context.Background(), "nobody@example.com")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want 404 APIError", err)
+	}
+}
+
+func TestCustomersCreateUpdateDelete(t *testing.T) {
+	var method, path, force string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, force = r.Method, r.URL.Path, r.URL.Query().Get("force")
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		w.Write([]byte(customerJSON))
+	})
+	ctx := context.Background()
+	if _, err := client.Customers.Create(ctx, &Customer{Email: "john.doe@example.com", Billing: &Address{City: "San Francisco"}}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["email"] != "john.doe@example.com" {
+		t.Errorf("create sent %s %v", method, body)
+	}
+	if _, err := client.Customers.Update(ctx, 25, &Customer{FirstName: "James"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/customers/25" || len(body) != 1 {
+		t.Errorf("update sent %s %s %v", method, path, body)
+	}
+	if _, err := client.Customers.Delete(ctx, 25, true); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || force != "true" {
+		t.Errorf("delete sent %s force=%q", method, force)
+	}
+}
+
+func TestCustomersPatch(t *testing.T) {
+	var method string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(customerJSON))
+	})
+	customer, err := client.Customers.Patch(context.Background(), 25, map[string]interface{}{
+		"first_name": "James",
+		"meta_data":  Meta{{Key: "_crm_id", Value: "C-1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || len(body) != 2 || body["first_name"] != "James" || body["meta_data"] == nil {
+		t.Errorf("patch sent %s %v", method, body)
+	}
+	if _, ok := body["billing"]; ok || customer.ID != 25 {
+		t.Errorf("patch sent %v, returned %+v", body, customer)
+	}
+}
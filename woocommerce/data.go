@@ -0,0 +1,189 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DataService reads the reference data at /data: countries, currencies and
+// continents. Responses are cached in memory for Option.DataCacheTTL.
+type DataService struct {
+	client *Client
+
+	mu    sync.Mutex
+	cache map[string]dataCacheEntry
+}
+
+type dataCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+type Country struct {
+	Code   string  `json:"code"`
+	Name   string  `json:"name"`
+	States []State `json:"states"`
+}
+
+type State struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type Currency struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	// Rate is the exchange rate from the store currency, set only by
+	// EnabledCurrencies on multi-currency stores.
+	Rate Decimal `json:"rate,omitzero"`
+}
+
+type Continent struct {
+	Code      string             `json:"code"`
+	Name      string             `json:"name"`
+	Countries []ContinentCountry `json:"countries"`
+}
+
+// ContinentCountry is a country of a continent with its locale defaults.
+type ContinentCountry struct {
+	Code          string  `json:"code"`
+	Name          string  `json:"name"`
+	CurrencyCode  string  `json:"currency_code"`
+	CurrencyPos   string  `json:"currency_pos"`
+	DecimalSep    string  `json:"decimal_sep"`
+	DimensionUnit string  `json:"dimension_unit"`
+	NumDecimals   int     `json:"num_decimals"`
+	ThousandSep   string  `json:"thousand_sep"`
+	WeightUnit    string  `json:"weight_unit"`
+	States        []State `json:"states"`
+}
+
+func (s *DataService) Countries(ctx context.Context) ([]Country, error) {
+	var countries []Country
+	err := s.get(ctx, "data/countries", &countries)
+	return countries, err
+}
+
+// Country reads a country by its ISO 3166 alpha-2 code, e.g. "US".
+func (s *DataService) Country(ctx context.Context, code string) (*Country, error) {
+	country := new(Country)
+	err := s.get(ctx, buildPath("data", "countries", strings.ToLower(code)), country)
+	return country, err
+}
+
+func (s *DataService) Currencies(ctx context.Context) ([]Currency, error) {
+	var currencies []Currency
+	err := s.get(ctx, "data/currencies", &currencies)
+	return currencies, err
+}
+
+// Currency reads a currency by its ISO 4217 code, e.g. "USD".
+func (s *DataService) Currency(ctx context.Context, code string) (*Currency, error) {
+	currency := new(Currency)
+	err := s.get(ctx, buildPath("data", "currencies", strings.ToLower(code)), currency)
+	return currency, err
+}
+
+// CurrentCurrency reads the store's currency.
+func (s *DataService) CurrentCurrency(ctx context.Context) (*Currency, error) {
+	currency := new(Currency)
+	err := s.get(ctx, "data/currencies/current", currency)
+	return currency, err
+}
+
+// multiCurrencyEndpoint lists the currencies of the WooPayments
+// multi-currency feature.
+const multiCurrencyEndpoint = "payments/multi-currency/currencies"
+
+// EnabledCurrencies returns the currencies customers can pay in, with
+// their exchange rates, sorted by code. Multi-currency plugins each store
+// these differently; the WooPayments multi-currency endpoint is read when
+// the store has it. Otherwise, as on single-currency stores, the store
+// currency alone is returned with a rate of 1.
+func (c *Client) EnabledCurrencies(ctx context.Context) ([]Currency, error) {
+	var store struct {
+		Enabled map[string]Currency `json:"enabled"`
+	}
+	_, err := c.call(ctx, http.MethodGet, multiCurrencyEndpoint, nil, nil, &store)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		current, err := c.Data.CurrentCurrency(ctx)
+		if err != nil {
+			return nil, err
+		}
+		current.Rate = NewDecimal(1, 0)
+		return []Currency{*current}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	currencies := make([]Currency, 0, len(store.Enabled))
+	for _, currency := range store.Enabled {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+	return currencies, nil
+}
+
+func (s *DataService) Continents(ctx context.Context) ([]Continent, error) {
+	var continents []Continent
+	err := s.get(ctx, "data/continents", &continents)
+	return continents, err
+}
+
+// Continent reads a continent by its code, e.g. "EU".
+func (s *DataService) Continent(ctx context.Context, code string) (*Continent, error) {
+	continent := new(Continent)
+	err := s.get(ctx, buildPath("data", "continents", strings.ToLower(code)), continent)
+	return continent, err
+}
+
+// ClearCache drops all cached responses.
+func (s *DataService) ClearCache() {
+	s.mu.Lock()
+	s.cache = nil
+	s.mu.Unlock()
+}
+
+// get decodes the response for endpoint into out, serving it from the
+// cache when caching is enabled and the cached copy has not expired.
+func (s *DataService) get(ctx context.Context, endpoint string, out interface{}) error {
+	ttl := s.client.option.DataCacheTTL
+	if ttl > 0 {
+		s.mu.Lock()
+		entry, ok := s.cache[endpoint]
+		s.mu.Unlock()
+		if ok && time.Now().Before(entry.expires) {
+			return json.Unmarshal(entry.body, out)
+		}
+	}
+	resp, err := s.client.request(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	body, err := io.ReadAll(resp)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return err
+	}
+	if ttl > 0 {
+		s.mu.Lock()
+		if s.cache == nil {
+			s.cache = make(map[string]dataCacheEntry)
+		}
+		s.cache[endpoint] = dataCacheEntry{body: body, expires: time.Now().Add(ttl)}
+		s.mu.Unlock()
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDataCountries(t *testing.T) {
+	var path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if path == "/wp-json/wc/v3/data/countries" {
+			w.Write([]byte(`[{"code":"US","name":"United States (US)","states":[{"code":"CA","name":"California"}]}]`))
+			return
+		}
+		w.Write([]byte(`{"code":"US","name":"United States (US)","states":[{"code":"CA","name":"California"}]}`))
+	})
+	ctx := context.Background()
+	countries, err := client.Data.Countries(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(countries) != 1 || countries[0].States[0].Name != "California" {
+		t.Errorf("countries = %+v", countries)
+	}
+	country, err := client.Data.Country(ctx, "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/data/countries/us" || country.Code != "US" {
+		t.Errorf("get %s = %+v", path, country)
+	}
+}
+
+func TestDataCurrentCurrency(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/data/currencies/current" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"code":"USD","name":"United States (US) dollar","symbol":"&#36;"}`))
+	})
+	currency, err := client.Data.CurrentCurrency(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if currency.Code != "USD" || currency.Symbol != "&#36;" {
+		t.Errorf("currency = %+v", currency)
+	}
+}
+
+func TestDataCache(t *testing.T) {
+	requests := 0
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"code":"EU","name":"Europe","countries":[{"code":"FR","name":"France","currency_code":"EUR","num_decimals":2}]}]`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{DataCacheTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		continents, err := client.Data.Continents(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(continents) != 1 || continents[0].Countries[0].CurrencyCode != "EUR" {
+			t.Fatalf("continents = %+v", continents)
+		}
+		// Changes by the caller must not leak into the cache.
+		continents[0].Name = "changed"
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+	client.Data.ClearCache()
+	continents, err := client.Data.Continents(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 || continents[0].Name != "Europe" {
+		t.Errorf("after ClearCache: requests = %d, continents = %+v", requests, continents)
+	}
+}
+
+func TestDataNoCache(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[]`))
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := client.Data.Currencies(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
+
+func TestEnabledCurrencies(t *testing.T) {
+	multiCurrency := true
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wp-json/wc/v3/payments/multi-currency/currencies":
+			if !multiCurrency {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"code":"rest_no_route","message":"No route was found matching the URL and request method.","data":{"status":404}}`))
+				return
+			}
+			w.Write([]byte(`{"available":{},"enabled":{
+				"USD":{"code":"USD","name":"United States (US) dollar","symbol":"$","rate":1,"is_default":true},
+				"EUR":{"code":"EUR","name":"Euro","symbol":"€","rate":0.9215,"is_default":false}
+			},"default":{"code":"USD"}}`))
+		case "/wp-json/wc/v3/data/currencies/current":
+			w.Write([]byte(`{"code":"GBP","name":"Pound sterling","symbol":"&pound;"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	currencies, err := client.EnabledCurrencies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(currencies) != 2 || currencies[0].Code != "EUR" || currencies[0].Rate.String() != "0.9215" || currencies[1].Rate.String() != "1" {
+		t.Errorf("currencies = %+v", currencies)
+	}
+
+	multiCurrency = false
+	currencies, err = client.EnabledCurrencies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(currencies) != 1 || currencies[0].Code != "GBP" || currencies[0].Rate.String() != "1" {
+		t.Errorf("fallback currencies = %+v", currencies)
+	}
+}
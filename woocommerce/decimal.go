@@ -0,0 +1,283 @@
+package woocommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, used for the prices and totals that
+// WooCommerce sends as strings such as "21.99". Arithmetic never overflows
+// or loses precision, so amounts need not be round-tripped through float64.
+//
+// The zero Decimal is unset: it marshals to "" and is left out of request
+// bodies by omitzero, while a parsed "0.00" is set and is sent. Use Sign to
+// test for a value of zero.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewDecimal returns unscaled × 10^-scale, e.g. NewDecimal(1999, 2) is 19.99.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(unscaled), scale)
+}
+
+func newDecimal(unscaled *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// ParseDecimal parses s, e.g. "-12.50" or "1.5e-3". An empty s gives the
+// unset Decimal.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, nil
+	}
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(s[i+1:], 10, 16); err != nil {
+			return Decimal{}, fmt.Errorf("Invalid decimal %q", s)
+		}
+		mantissa = s[:i]
+	}
+	digits := mantissa
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digits = mantissa[:i] + mantissa[i+1:]
+		exp -= int64(len(mantissa) - i - 1)
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("Invalid decimal %q", s)
+	}
+	return newDecimal(unscaled, int32(-exp)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is invalid. It
+// is meant for constants, e.g. MustParseDecimal("19.99").
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// IsZero reports whether d is unset.
+func (d Decimal) IsZero() bool {
+	return d.unscaled == nil
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// rescale returns the unscaled value of d at the given, larger, scale.
+func (d Decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+func align(x, y Decimal) (a, b *big.Int, scale int32) {
+	scale = max(x.scale, y.scale)
+	return x.rescale(scale), y.rescale(scale), scale
+}
+
+// Add returns d + x.
+func (d Decimal) Add(x Decimal) Decimal {
+	a, b, scale := align(d, x)
+	return Decimal{unscaled: a.Add(a, b), scale: scale}
+}
+
+// Sub returns d - x.
+func (d Decimal) Sub(x Decimal) Decimal {
+	a, b, scale := align(d, x)
+	return Decimal{unscaled: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d × x, e.g. a price times a quantity from NewDecimal(qty, 0).
+func (d Decimal) Mul(x Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), x.int()), scale: d.scale + x.scale}
+}
+
+// Div returns d / x rounded half away from zero to places digits after the
+// decimal point. It panics if x is zero.
+func (d Decimal) Div(x Decimal, places int32) Decimal {
+	if x.Sign() == 0 {
+		panic("woocommerce: Decimal division by zero")
+	}
+	// d/x = (a × 10^-sa) / (b × 10^-sb); scale the numerator so that the
+	// quotient has one digit more than asked for, then round it.
+	num := new(big.Int).Mul(d.int(), pow10(max(0, places+1+x.scale-d.scale)))
+	den := new(big.Int).Mul(x.int(), pow10(max(0, d.scale-x.scale-places-1)))
+	q := Decimal{unscaled: num.Quo(num, den), scale: places + 1}
+	return q.Round(places)
+}
+
+// Round returns d rounded half away from zero to places digits after the
+// decimal point, e.g. to the 2 decimals of most currencies.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return Decimal{unscaled: d.rescale(places), scale: places}
+	}
+	q, r := new(big.Int).QuoRem(d.int(), pow10(d.scale-places), new(big.Int))
+	half := new(big.Int).Mul(big.NewInt(5), pow10(d.scale-places-1))
+	if r.CmpAbs(half) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	return Decimal{unscaled: q, scale: places}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Sign returns -1, 0 or +1 as d is negative, zero or positive. An unset
+// Decimal counts as zero.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// Cmp compares d and x by value, returning -1, 0 or +1, so that "10.5"
+// and "10.50" are equal.
+func (d Decimal) Cmp(x Decimal) int {
+	a, b, _ := align(d, x)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and x have the same value.
+func (d Decimal) Equal(x Decimal) bool {
+	return d.Cmp(x) == 0
+}
+
+// Float64 returns the nearest float64 to d, for charts and display only.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String formats d with its own scale, e.g. "21.99", or "" if it is unset.
+func (d Decimal) String() string {
+	if d.unscaled == nil {
+		return ""
+	}
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON encodes d as a string, which is how WooCommerce sends and
+// accepts amounts.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a string, a number or null.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// ErrCurrencyMismatch is returned when amounts in different currencies are
+// combined.
+var ErrCurrencyMismatch = errors.New("Currency mismatch")
+
+// Money is an amount in a currency, given as an ISO 4217 code such as
+// "USD". Build one for an order amount with Order.Money.
+type Money struct {
+	Amount   Decimal `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+func (m Money) check(x Money) error {
+	if m.Currency != x.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, x.Currency)
+	}
+	return nil
+}
+
+// Add returns m + x, failing with ErrCurrencyMismatch if their currencies
+// differ.
+func (m Money) Add(x Money) (Money, error) {
+	if err := m.check(x); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(x.Amount), Currency: m.Currency}, nil
+}
+
+// Sub returns m - x, failing with ErrCurrencyMismatch if their currencies
+// differ.
+func (m Money) Sub(x Money) (Money, error) {
+	if err := m.check(x); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Sub(x.Amount), Currency: m.Currency}, nil
+}
+
+// Mul returns m × x, e.g. a unit price times a quantity.
+func (m Money) Mul(x Decimal) Money {
+	return Money{Amount: m.Amount.Mul(x), Currency: m.Currency}
+}
+
+// Cmp compares m and x, failing with ErrCurrencyMismatch if their
+// currencies differ.
+func (m Money) Cmp(x Money) (int, error) {
+	if err := m.check(x); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(x.Amount), nil
+}
+
+// String formats m as e.g. "29.35 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency
+}
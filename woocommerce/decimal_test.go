@@ -0,0 +1,106 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"21.99", "21.99"},
+		{"-0.5", "-0.5"},
+		{".05", "0.05"},
+		{"10", "10"},
+		{"1.5e-3", "0.0015"},
+		{"2E2", "200"},
+		{" 3.10 ", "3.10"},
+		{"", ""},
+	} {
+		d, err := ParseDecimal(tc.in)
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if d.String() != tc.want {
+			t.Errorf("%q = %s, want %s", tc.in, d, tc.want)
+		}
+	}
+	for _, in := range []string{"abc", "1.2.3", "1e", "-", "."} {
+		if _, err := ParseDecimal(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	price := MustParseDecimal("19.99")
+	if got := price.Mul(NewDecimal(3, 0)).String(); got != "59.97" {
+		t.Errorf("mul = %s", got)
+	}
+	// 0.1 + 0.2 is exact, unlike float64.
+	if got := MustParseDecimal("0.1").Add(MustParseDecimal("0.2")); !got.Equal(MustParseDecimal("0.3")) {
+		t.Errorf("add = %s", got)
+	}
+	if got := MustParseDecimal("10").Sub(MustParseDecimal("0.01")).String(); got != "9.99" {
+		t.Errorf("sub = %s", got)
+	}
+	if got := MustParseDecimal("10").Div(NewDecimal(3, 0), 2).String(); got != "3.33" {
+		t.Errorf("div = %s", got)
+	}
+	if got := MustParseDecimal("-2.675").Round(2).String(); got != "-2.68" {
+		t.Errorf("round = %s", got)
+	}
+	if got := MustParseDecimal("7").Round(2).String(); got != "7.00" {
+		t.Errorf("round up = %s", got)
+	}
+	if MustParseDecimal("10.5").Cmp(MustParseDecimal("10.50")) != 0 || MustParseDecimal("2").Cmp(MustParseDecimal("10")) != -1 {
+		t.Error("cmp compares strings, not values")
+	}
+	var unset Decimal
+	if unset.Add(price).String() != "19.99" || unset.Sign() != 0 {
+		t.Error("unset Decimal is not zero")
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var item struct {
+		Price Decimal `json:"price"`
+		Total Decimal `json:"total"`
+		Tax   Decimal `json:"tax"`
+		Sale  Decimal `json:"sale"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":18.5,"total":"37.00","tax":null,"sale":""}`), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Price.String() != "18.5" || item.Total.String() != "37.00" || !item.Tax.IsZero() || !item.Sale.IsZero() {
+		t.Errorf("item = %+v", item)
+	}
+	if err := json.Unmarshal([]byte(`{"price":"free"}`), &item); err == nil {
+		t.Error("expected error for invalid price")
+	}
+
+	data, err := json.Marshal(&Product{Name: "Hoodie", RegularPrice: MustParseDecimal("45.00"), SalePrice: NewDecimal(0, 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"Hoodie","regular_price":"45.00","sale_price":"0"}` {
+		t.Errorf("product = %s", data)
+	}
+}
+
+func TestMoney(t *testing.T) {
+	order := &Order{Currency: "USD", Total: MustParseDecimal("29.35"), ShippingTotal: MustParseDecimal("10.00")}
+	sum, err := order.Money(order.Total).Add(order.Money(order.ShippingTotal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum.String() != "39.35 USD" {
+		t.Errorf("sum = %s", sum)
+	}
+	if _, err := sum.Sub(Money{Amount: NewDecimal(1, 0), Currency: "EUR"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("err = %v, want ErrCurrencyMismatch", err)
+	}
+}
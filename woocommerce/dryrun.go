@@ -0,0 +1,93 @@
+package woocommerce
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// DryRun collects the requests that would change the store instead of
+// sending them, so that a bulk script can be previewed before it runs
+// against production. Set it as Option.DryRun; reads are still sent.
+//
+// Every POST, PUT, DELETE or other mutating request is answered with an
+// empty JSON object and status 200, so typed services return zero values
+// for created and updated resources.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// PlannedRequest is a request captured by a DryRun.
+type PlannedRequest struct {
+	Method   string
+	Endpoint string
+	Params   url.Values
+	Body     []byte
+}
+
+func (p PlannedRequest) String() string {
+	s := p.Method + " " + p.Endpoint
+	if len(p.Params) > 0 {
+		s += "?" + p.Params.Encode()
+	}
+	if len(p.Body) > 0 {
+		s += " " + string(p.Body)
+	}
+	return s
+}
+
+// Requests returns the captured requests in the order they were made.
+func (d *DryRun) Requests() []PlannedRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]PlannedRequest(nil), d.requests...)
+}
+
+// Reset forgets the captured requests.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests = nil
+}
+
+// WriteTo writes the captured requests to w, one per line.
+func (d *DryRun) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, req := range d.Requests() {
+		m, err := fmt.Fprintln(w, req)
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// capture records a mutating request and returns the response that stands
+// in for it. It returns false for reads, which are sent as usual.
+func (d *DryRun) capture(method, endpoint string, params url.Values, payload []byte) (*http.Response, bool) {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil, false
+	}
+	req := PlannedRequest{Method: method, Endpoint: endpoint, Body: append([]byte(nil), payload...)}
+	if len(params) > 0 {
+		req.Params = url.Values{}
+		for k, v := range params {
+			req.Params[k] = append([]string(nil), v...)
+		}
+	}
+	d.mu.Lock()
+	d.requests = append(d.requests, req)
+	d.mu.Unlock()
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, true
+}
@@ -0,0 +1,68 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	var sent []string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"id":794,"name":"Premium Quality","stock_quantity":5}`))
+	})
+	plan := &DryRun{}
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{DryRun: plan})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	product, err := client.Products.Get(ctx, 794)
+	if err != nil || product.Name != "Premium Quality" {
+		t.Fatalf("get = %+v, %v", product, err)
+	}
+	updated, err := client.Products.Update(ctx, 794, &Product{Name: "Premium"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ID != 0 {
+		t.Errorf("dry run update returned %+v", updated)
+	}
+	if _, err := client.Products.Delete(ctx, 794, true); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(ctx, "PATCH", "/wc-bookings/v1/bookings/7", nil, strings.NewReader(`{"status":"paid"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(sent) != 1 || sent[0] != "GET /wp-json/wc/v3/products/794" {
+		t.Errorf("sent %v", sent)
+	}
+	requests := plan.Requests()
+	if len(requests) != 3 {
+		t.Fatalf("planned %v", requests)
+	}
+	if requests[0].Method != http.MethodPut || requests[0].Endpoint != "products/794" || string(requests[0].Body) != `{"name":"Premium"}` {
+		t.Errorf("update planned as %+v", requests[0])
+	}
+	if requests[1].Method != http.MethodDelete || requests[1].Params.Get("force") != "true" {
+		t.Errorf("delete planned as %+v", requests[1])
+	}
+
+	var out bytes.Buffer
+	plan.WriteTo(&out)
+	want := "PUT products/794 {\"name\":\"Premium\"}\nDELETE products/794?force=true\nPATCH /wc-bookings/v1/bookings/7 {\"status\":\"paid\"}\n"
+	if out.String() != want {
+		t.Errorf("plan = %q", out.String())
+	}
+	plan.Reset()
+	if len(plan.Requests()) != 0 {
+		t.Error("reset kept requests")
+	}
+}
@@ -0,0 +1,71 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIError is returned for responses with a non-success status. WooCommerce
+// error bodies carry a machine readable code such as
+// woocommerce_rest_product_invalid_id alongside a human readable message.
+type APIError struct {
+	StatusCode int          `json:"-"`
+	Status     string       `json:"-"`
+	Code       string       `json:"code"`
+	Message    string       `json:"message"`
+	Data       APIErrorData `json:"data"`
+	// RetryAfter is the delay asked for by a Retry-After header, usually
+	// sent with 429 and 503 responses, or zero.
+	RetryAfter time.Duration `json:"-"`
+}
+
+type APIErrorData struct {
+	Status int               `json:"status"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("Request failed: %s", e.Status)
+	}
+	return fmt.Sprintf("Request failed: %s: %s (%s)", e.Status, e.Message, e.Code)
+}
+
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err == nil && len(data) > 0 {
+		if json.Unmarshal(data, apiErr) != nil {
+			apiErr.Code, apiErr.Message = "", ""
+		}
+	}
+	return apiErr
+}
+
+// parseRetryAfter reads a Retry-After header in either of its forms, a
+// number of seconds or an HTTP date. Dates in the past give zero.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"woocommerce_rest_product_invalid_id","message":"Invalid ID.","data":{"status":404}}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Get(context.Background(), "products/1", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Data.Status != http.StatusNotFound {
+		t.Errorf("status = %d/%d, want 404", apiErr.StatusCode, apiErr.Data.Status)
+	}
+	if apiErr.Code != "woocommerce_rest_product_invalid_id" || apiErr.Message != "Invalid ID." {
+		t.Errorf("unexpected error payload: %+v", apiErr)
+	}
+	if want := "Request failed: 404 Not Found: Invalid ID. (woocommerce_rest_product_invalid_id)"; apiErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", apiErr.Error(), want)
+	}
+}
+
+func TestAPIErrorNonJSON(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "<html>Bad Gateway</html>", http.StatusBadGateway)
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Get(context.Background(), "products", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.Code != "" || apiErr.Error() != "Request failed: 502 Bad Gateway" {
+		t.Errorf("unexpected error: %+v", apiErr)
+	}
+}
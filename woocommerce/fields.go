@@ -0,0 +1,102 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type fieldsContextKey struct{}
+
+// WithFields returns a context that limits responses to requests made with
+// it to the given fields, by setting the _fields parameter. Typed services
+// then return models with the other fields at their zero value.
+func WithFields(ctx context.Context, fields ...string) context.Context {
+	return context.WithValue(ctx, fieldsContextKey{}, strings.Join(fields, ","))
+}
+
+func fieldsFrom(ctx context.Context) string {
+	fields, _ := ctx.Value(fieldsContextKey{}).(string)
+	return fields
+}
+
+// Field holds a value decoded from a response limited with _fields. Set
+// reports whether the field was present, which tells an absent field from
+// a zero value.
+//
+//	var stock []struct {
+//		ID            int                   `json:"id"`
+//		StockQuantity woocommerce.Field[int] `json:"stock_quantity"`
+//	}
+type Field[T any] struct {
+	Value T
+	Set   bool
+}
+
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	f.Set = true
+	if string(data) == "null" {
+		var zero T
+		f.Value = zero
+		return nil
+	}
+	return json.Unmarshal(data, &f.Value)
+}
+
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if !f.Set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(f.Value)
+}
+
+// Partial is an item decoded from a response limited with _fields, keyed
+// by field name.
+type Partial map[string]json.RawMessage
+
+// Has reports whether the response included field.
+func (p Partial) Has(field string) bool {
+	_, ok := p[field]
+	return ok
+}
+
+// Decode unmarshals field into v and reports whether it was present.
+func (p Partial) Decode(field string, v interface{}) (bool, error) {
+	raw, ok := p[field]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+// Into unmarshals the fields present into v, e.g. a *Product or a struct
+// of Field values.
+func (p Partial) Into(v interface{}) error {
+	data, err := json.Marshal(map[string]json.RawMessage(p))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ListPartial lists endpoint returning only fields of each item.
+func (c *Client) ListPartial(ctx context.Context, endpoint string, params *ListParams, fields ...string) ([]Partial, *Response, error) {
+	query, err := params.Values()
+	if err != nil {
+		return nil, nil, err
+	}
+	query.Set("_fields", strings.Join(fields, ","))
+	var items []Partial
+	resp, err := c.call(ctx, http.MethodGet, endpoint, query, nil, &items)
+	return items, resp, err
+}
+
+// GetPartial reads endpoint returning only fields.
+func (c *Client) GetPartial(ctx context.Context, endpoint string, fields ...string) (Partial, error) {
+	var item Partial
+	query := url.Values{"_fields": {strings.Join(fields, ",")}}
+	_, err := c.call(ctx, http.MethodGet, endpoint, query, nil, &item)
+	return item, err
+}
@@ -0,0 +1,66 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestListPartial(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("_fields"); got != "id,sku,stock_quantity" {
+			t.Errorf("_fields = %q", got)
+		}
+		w.Write([]byte(`[{"id":794,"sku":"PQ-1","stock_quantity":0},{"id":795,"sku":"","stock_quantity":null}]`))
+	})
+	items, _, err := client.ListPartial(context.Background(), "products", NewListParams().Status("publish"), "id", "sku", "stock_quantity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || !items[0].Has("stock_quantity") || items[0].Has("name") {
+		t.Fatalf("items = %v", items)
+	}
+	var qty *int
+	if ok, err := items[1].Decode("stock_quantity", &qty); !ok || err != nil || qty != nil {
+		t.Errorf("stock of 795 = %v, %v, %v", qty, ok, err)
+	}
+
+	var stock struct {
+		ID            int           `json:"id"`
+		Name          Field[string] `json:"name"`
+		StockQuantity Field[int]    `json:"stock_quantity"`
+	}
+	if err := items[0].Into(&stock); err != nil {
+		t.Fatal(err)
+	}
+	if stock.ID != 794 || stock.Name.Set || !stock.StockQuantity.Set || stock.StockQuantity.Value != 0 {
+		t.Errorf("stock = %+v", stock)
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	var fields []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fields = append(fields, r.URL.Query().Get("_fields"))
+		w.Write([]byte(`{"id":794,"sku":"PQ-1"}`))
+	})
+	ctx := context.Background()
+	product, err := client.Products.Get(WithFields(ctx, "id", "sku"), 794)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.SKU != "PQ-1" {
+		t.Errorf("product = %+v", product)
+	}
+	if _, err := client.Products.Update(WithFields(ctx, "id"), 794, &Product{}); err != nil {
+		t.Fatal(err)
+	}
+	if fields[0] != "id,sku" || fields[1] != "" {
+		t.Errorf("_fields sent = %q", fields)
+	}
+	data, _ := json.Marshal(Field[int]{})
+	if string(data) != "null" {
+		t.Errorf("unset field marshals to %s", data)
+	}
+}
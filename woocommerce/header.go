@@ -0,0 +1,25 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+type headerContextKey struct{}
+
+// WithHeader returns a context that sets the header key to value on
+// requests made with it, overriding Option.Header and the headers the
+// client sets itself. Calls can be chained to set several headers.
+func WithHeader(ctx context.Context, key, value string) context.Context {
+	header := headerFrom(ctx).Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(key, value)
+	return context.WithValue(ctx, headerContextKey{}, header)
+}
+
+func headerFrom(ctx context.Context) http.Header {
+	header, _ := ctx.Value(headerContextKey{}).(http.Header)
+	return header
+}
@@ -0,0 +1,54 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestHeaders(t *testing.T) {
+	var got http.Header
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`{}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{
+		Header:   http.Header{"X-Store-Token": {"abc"}, "x-plugin": {"1"}},
+		Language: "fr",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := client.Orders.Get(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("User-Agent") != UserAgent || got.Get("X-Store-Token") != "abc" || got.Get("X-Plugin") != "1" {
+		t.Errorf("headers = %v", got)
+	}
+	ctx = WithHeader(WithHeader(ctx, "Accept-Language", "de"), "X-Request-ID", "r-1")
+	if _, err := client.Orders.Get(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("Accept-Language") != "de" || got.Get("X-Request-ID") != "r-1" || got.Get("X-Store-Token") != "abc" {
+		t.Errorf("per-request headers = %v", got)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	var got string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		got = r.UserAgent()
+		w.Write([]byte(`{}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{UserAgent: "inventory-sync/2.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Orders.Get(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if got != "inventory-sync/2.1" {
+		t.Errorf("User-Agent = %q", got)
+	}
+}
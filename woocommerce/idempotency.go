@@ -0,0 +1,158 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that attaches key to POST requests
+// as the Idempotency-Key header. When Option.IdempotencyLedger is set the
+// key is also used to suppress duplicate creates; see IdempotencyLedger.
+// Keys must be unique per logical operation, e.g. derived from the ID of
+// the record being synced.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+func idempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// IdempotencyRecord is the outcome of a POST sent with an idempotency key.
+// Pending records belong to requests whose outcome is not known yet.
+type IdempotencyRecord struct {
+	Pending    bool
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyLedger remembers POST requests sent with an idempotency key.
+//
+// WooCommerce itself does not deduplicate requests, so the ledger does it
+// on the client: a key that already succeeded returns the recorded
+// response without sending anything. A request claims its key with a
+// pending record, so concurrent requests with the key fail with an
+// *InDoubtError, and releases it if it fails before it is sent or with an
+// error response. A request that fails without a response once sent, such
+// as on a dropped connection, may still have been applied; it is not
+// retried and the key stays pending, so later requests with it fail with
+// an *InDoubtError until the caller has checked the store and called
+// Delete.
+type IdempotencyLedger interface {
+	// LoadOrStore returns the record of key if there is one. Otherwise it
+	// stores record and returns it, with loaded false. It must be atomic.
+	LoadOrStore(key string, record *IdempotencyRecord) (actual *IdempotencyRecord, loaded bool)
+	Store(key string, record *IdempotencyRecord)
+	Delete(key string)
+}
+
+// InDoubtError is returned for a request whose idempotency key has a
+// pending record, meaning an earlier request with the key may or may not
+// have been applied. Err is the failure that left it pending, when known.
+type InDoubtError struct {
+	Key string
+	Err error
+}
+
+func (e *InDoubtError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("Request with idempotency key %s may already have been applied", e.Key)
+	}
+	return fmt.Sprintf("Request with idempotency key %s may already have been applied: %v", e.Key, e.Err)
+}
+
+func (e *InDoubtError) Unwrap() error {
+	return e.Err
+}
+
+// MemoryLedger is an IdempotencyLedger kept in memory. It is safe for
+// concurrent use.
+type MemoryLedger struct {
+	mu      sync.Mutex
+	records map[string]*IdempotencyRecord
+}
+
+func NewMemoryLedger() *MemoryLedger {
+	return &MemoryLedger{records: make(map[string]*IdempotencyRecord)}
+}
+
+func (l *MemoryLedger) Load(key string) (*IdempotencyRecord, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record, ok := l.records[key]
+	return record, ok
+}
+
+func (l *MemoryLedger) LoadOrStore(key string, record *IdempotencyRecord) (*IdempotencyRecord, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if actual, ok := l.records[key]; ok {
+		return actual, true
+	}
+	l.records[key] = record
+	return record, false
+}
+
+func (l *MemoryLedger) Store(key string, record *IdempotencyRecord) {
+	l.mu.Lock()
+	l.records[key] = record
+	l.mu.Unlock()
+}
+
+func (l *MemoryLedger) Delete(key string) {
+	l.mu.Lock()
+	delete(l.records, key)
+	l.mu.Unlock()
+}
+
+// ledgerKey returns the idempotency key of a request if it is tracked by
+// the client's ledger.
+func (c *Client) ledgerKey(ctx context.Context, method string) string {
+	if method != http.MethodPost || c.option.IdempotencyLedger == nil {
+		return ""
+	}
+	return idempotencyKeyFrom(ctx)
+}
+
+// claim marks key pending for a new request. If the key has been used it
+// returns the recorded response, or an *InDoubtError if its outcome is
+// unknown, with ok true.
+func (c *Client) claim(key string) (resp *Response, ok bool, err error) {
+	record, ok := c.option.IdempotencyLedger.LoadOrStore(key, &IdempotencyRecord{Pending: true})
+	if !ok {
+		return nil, false, nil
+	}
+	if record.Pending {
+		return nil, true, &InDoubtError{Key: key}
+	}
+	return newResponse(&http.Response{
+		StatusCode: record.StatusCode,
+		Header:     record.Header,
+		Body:       io.NopCloser(bytes.NewReader(record.Body)),
+	}), true, nil
+}
+
+// settle records a successful response for key and returns a copy of it
+// with the body buffered.
+func (c *Client) settle(key string, resp *http.Response) (*http.Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, &InDoubtError{Key: key, Err: err}
+	}
+	c.option.IdempotencyLedger.Store(key, &IdempotencyRecord{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
@@ -0,0 +1,210 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKeyHeader(t *testing.T) {
+	var keys []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.Write([]byte(`{}`))
+	})
+	ctx := WithIdempotencyKey(context.Background(), "sync-42")
+	for _, send := range []func() (*Response, error){
+		func() (*Response, error) { return client.Post(ctx, "products", strings.NewReader(`{}`)) },
+		func() (*Response, error) { return client.Get(ctx, "products", nil) },
+	} {
+		resp, err := send()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Close()
+	}
+	if len(keys) != 2 || keys[0] != "sync-42" || keys[1] != "" {
+		t.Errorf("keys = %q", keys)
+	}
+}
+
+func TestIdempotencyLedgerReplay(t *testing.T) {
+	var requests atomic.Int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":794,"name":"Premium Quality"}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{IdempotencyLedger: NewMemoryLedger()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithIdempotencyKey(context.Background(), "product-abc")
+	for i := 0; i < 2; i++ {
+		product, err := client.Products.Create(ctx, &Product{Name: "Premium Quality"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if product.ID != 794 {
+			t.Errorf("product = %+v", product)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1", requests.Load())
+	}
+	if _, err := client.Products.Create(WithIdempotencyKey(context.Background(), "product-def"), &Product{}); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests with a new key = %d, want 2", requests.Load())
+	}
+}
+
+func TestIdempotencyLedgerInDoubt(t *testing.T) {
+	var requests atomic.Int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// The order is created but the connection drops before the
+			// response arrives.
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":727}`))
+	})
+	ledger := NewMemoryLedger()
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{
+		IdempotencyLedger: ledger,
+		Retry:             &RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithIdempotencyKey(context.Background(), "order-1")
+	var inDoubt *InDoubtError
+	if _, err := client.Orders.Create(ctx, &Order{}); !errors.As(err, &inDoubt) || inDoubt.Err == nil {
+		t.Fatalf("err = %v, want *InDoubtError", err)
+	}
+	if _, err := client.Orders.Create(ctx, &Order{}); !errors.As(err, &inDoubt) {
+		t.Fatalf("second err = %v, want *InDoubtError", err)
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("requests = %d, want 1", requests.Load())
+	}
+	ledger.Delete("order-1")
+	order, err := client.Orders.Create(ctx, &Order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 727 || requests.Load() != 2 {
+		t.Errorf("order = %+v, requests = %d", order, requests.Load())
+	}
+}
+
+func TestIdempotencyLedgerRetriesErrorStatus(t *testing.T) {
+	var requests atomic.Int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":1}`))
+	})
+	ledger := NewMemoryLedger()
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{
+		IdempotencyLedger: ledger,
+		Retry:             &RetryPolicy{MaxAttempts: 2, BackoffBase: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Post(WithIdempotencyKey(context.Background(), "k"), "coupons", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp)
+	resp.Close()
+	record, ok := ledger.Load("k")
+	if requests.Load() != 2 || string(body) != `{"id":1}` || !ok || record.Pending || string(record.Body) != `{"id":1}` {
+		t.Errorf("requests = %d, body = %s, record = %+v", requests.Load(), body, record)
+	}
+}
+
+type authFunc func(ctx context.Context, req *http.Request) error
+
+func (f authFunc) Authenticate(ctx context.Context, req *http.Request) error {
+	return f(ctx, req)
+}
+
+func TestIdempotencyLedgerUnsent(t *testing.T) {
+	var requests atomic.Int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":727}`))
+	})
+	authErr := errors.New("token endpoint unavailable")
+	var failAuth atomic.Bool
+	failAuth.Store(true)
+	ledger := NewMemoryLedger()
+	client, err := NewClient(srv.URL, "", "", &Option{
+		IdempotencyLedger: ledger,
+		Auth: authFunc(func(ctx context.Context, req *http.Request) error {
+			if failAuth.Load() {
+				return authErr
+			}
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithIdempotencyKey(context.Background(), "order-1")
+	var inDoubt *InDoubtError
+	if _, err := client.Orders.Create(ctx, &Order{}); !errors.Is(err, authErr) || errors.As(err, &inDoubt) {
+		t.Fatalf("auth failure err = %v, want %v", err, authErr)
+	}
+	failAuth.Store(false)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := client.Orders.Create(cancelled, &Order{}); !errors.Is(err, context.Canceled) || errors.As(err, &inDoubt) {
+		t.Fatalf("cancelled err = %v, want %v", err, context.Canceled)
+	}
+	if _, ok := ledger.Load("order-1"); ok || requests.Load() != 0 {
+		t.Fatalf("key left in ledger after %d requests", requests.Load())
+	}
+	order, err := client.Orders.Create(ctx, &Order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 727 || requests.Load() != 1 {
+		t.Errorf("order = %+v, requests = %d", order, requests.Load())
+	}
+}
+
+func TestMemoryLedgerLoadOrStore(t *testing.T) {
+	ledger := NewMemoryLedger()
+	var claimed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, loaded := ledger.LoadOrStore("k", &IdempotencyRecord{Pending: true}); !loaded {
+				claimed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := claimed.Load(); n != 1 {
+		t.Errorf("key claimed %d times, want 1", n)
+	}
+}
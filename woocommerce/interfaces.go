@@ -0,0 +1,375 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// The interfaces below are implemented by Client and its services. Code
+// that depends on them rather than on the concrete types can be tested
+// with fakes instead of a store.
+
+// API is the untyped request interface of Client.
+type API interface {
+	Post(ctx context.Context, endpoint string, data io.Reader) (*Response, error)
+	Put(ctx context.Context, endpoint string, data io.Reader) (*Response, error)
+	Get(ctx context.Context, endpoint string, params url.Values) (*Response, error)
+	Delete(ctx context.Context, endpoint string, force bool, params url.Values) (*Response, error)
+	Options(ctx context.Context, endpoint string) (*Response, error)
+	ListAll(ctx context.Context, endpoint string, params url.Values, fn func(items []json.RawMessage) error) error
+	Stream(ctx context.Context, endpoint string, params url.Values, fn func(item json.RawMessage) error) error
+	BulkFetch(ctx context.Context, endpoint string, params url.Values, opts *BulkOptions, fn func(items []json.RawMessage) error) error
+	ListPartial(ctx context.Context, endpoint string, params *ListParams, fields ...string) ([]Partial, *Response, error)
+	GetPartial(ctx context.Context, endpoint string, fields ...string) (Partial, error)
+	Do(ctx context.Context, method, endpoint string, params url.Values, body io.Reader, opts ...RequestOption) (*http.Response, error)
+}
+
+type ProductsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Product, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Product) error) error
+	Stream(ctx context.Context, params *ListParams, fn func(*Product) error) error
+	BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Product) error) error
+	Get(ctx context.Context, id int) (*Product, error)
+	Create(ctx context.Context, product *Product) (*Product, error)
+	Update(ctx context.Context, id int, product *Product) (*Product, error)
+	Delete(ctx context.Context, id int, force bool) (*Product, error)
+	Batch(ctx context.Context, req *BatchRequest[Product]) (*BatchResponse[Product], error)
+	Reorder(ctx context.Context, orderedIDs []int) error
+	PriceRange(ctx context.Context, productID int) (min, max Decimal, err error)
+	TotalStock(ctx context.Context, productID int) (total int, managed bool, err error)
+	IndexByMeta(ctx context.Context, metaKey string, params *ListParams) (map[string]int, error)
+}
+
+type OrdersAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Order, *Response, error)
+	ListByStatus(ctx context.Context, params *ListParams, statuses ...OrderStatus) ([]Order, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Order) error) error
+	Stream(ctx context.Context, params *ListParams, fn func(*Order) error) error
+	BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Order) error) error
+	Get(ctx context.Context, id int) (*Order, error)
+	Create(ctx context.Context, order *Order) (*Order, error)
+	Update(ctx context.Context, id int, order *Order) (*Order, error)
+	Delete(ctx context.Context, id int, force bool) (*Order, error)
+	Batch(ctx context.Context, req *BatchRequest[Order]) (*BatchResponse[Order], error)
+	CreateByExternalID(ctx context.Context, externalID string, order *Order) (*Order, error)
+	Poll(ctx context.Context, interval time.Duration, cursor *SyncCursor, fn func(Order) error) error
+	CountByStatus(ctx context.Context) (map[OrderStatus]int, error)
+}
+
+type CustomersAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Customer, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Customer) error) error
+	Stream(ctx context.Context, params *ListParams, fn func(*Customer) error) error
+	BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Customer) error) error
+	Get(ctx context.Context, id int) (*Customer, error)
+	GetByEmail(ctx context.Context, email string) (*Customer, error)
+	Create(ctx context.Context, customer *Customer) (*Customer, error)
+	Update(ctx context.Context, id int, customer *Customer) (*Customer, error)
+	Patch(ctx context.Context, id int, changes map[string]interface{}) (*Customer, error)
+	Delete(ctx context.Context, id int, force bool) (*Customer, error)
+	Batch(ctx context.Context, req *BatchRequest[Customer]) (*BatchResponse[Customer], error)
+}
+
+type CouponsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Coupon, *Response, error)
+	Get(ctx context.Context, id int) (*Coupon, error)
+	Create(ctx context.Context, coupon *Coupon) (*Coupon, error)
+	Update(ctx context.Context, id int, coupon *Coupon) (*Coupon, error)
+	Delete(ctx context.Context, id int, force bool) (*Coupon, error)
+	Batch(ctx context.Context, req *BatchRequest[Coupon]) (*BatchResponse[Coupon], error)
+}
+
+type RefundsAPI interface {
+	List(ctx context.Context, orderID int, params *ListParams) ([]Refund, *Response, error)
+	Get(ctx context.Context, orderID, refundID int) (*Refund, error)
+	Create(ctx context.Context, orderID int, refund *Refund) (*Refund, error)
+	Delete(ctx context.Context, orderID, refundID int) (*Refund, error)
+}
+
+type OrderNotesAPI interface {
+	List(ctx context.Context, orderID int, params *ListParams) ([]OrderNote, *Response, error)
+	Get(ctx context.Context, orderID, noteID int) (*OrderNote, error)
+	Create(ctx context.Context, orderID int, note *OrderNote) (*OrderNote, error)
+	Delete(ctx context.Context, orderID, noteID int) (*OrderNote, error)
+}
+
+type ProductVariationsAPI interface {
+	List(ctx context.Context, productID int, params *ListParams) ([]ProductVariation, *Response, error)
+	ListAll(ctx context.Context, productID int, params *ListParams, fn func([]ProductVariation) error) error
+	Get(ctx context.Context, productID, id int) (*ProductVariation, error)
+	Create(ctx context.Context, productID int, variation *ProductVariation) (*ProductVariation, error)
+	Update(ctx context.Context, productID, id int, variation *ProductVariation) (*ProductVariation, error)
+	Delete(ctx context.Context, productID, id int, force bool) (*ProductVariation, error)
+	Batch(ctx context.Context, productID int, req *BatchRequest[ProductVariation]) (*BatchResponse[ProductVariation], error)
+}
+
+type ProductCategoriesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ProductCategory, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]ProductCategory) error) error
+	Get(ctx context.Context, id int) (*ProductCategory, error)
+	Create(ctx context.Context, category *ProductCategory) (*ProductCategory, error)
+	Update(ctx context.Context, id int, category *ProductCategory) (*ProductCategory, error)
+	Delete(ctx context.Context, id int) (*ProductCategory, error)
+	Batch(ctx context.Context, req *BatchRequest[ProductCategory]) (*BatchResponse[ProductCategory], error)
+	Tree(ctx context.Context) ([]*CategoryNode, error)
+	GetByPath(ctx context.Context, path string) (*ProductCategory, error)
+}
+
+type ProductTagsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ProductTag, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]ProductTag) error) error
+	Get(ctx context.Context, id int) (*ProductTag, error)
+	Create(ctx context.Context, tag *ProductTag) (*ProductTag, error)
+	Update(ctx context.Context, id int, tag *ProductTag) (*ProductTag, error)
+	Delete(ctx context.Context, id int) (*ProductTag, error)
+	Batch(ctx context.Context, req *BatchRequest[ProductTag]) (*BatchResponse[ProductTag], error)
+	Ensure(ctx context.Context, names ...string) ([]int, error)
+}
+
+type ProductAttributesAPI interface {
+	List(ctx context.Context) ([]Attribute, error)
+	Get(ctx context.Context, id int) (*Attribute, error)
+	Create(ctx context.Context, attribute *Attribute) (*Attribute, error)
+	Update(ctx context.Context, id int, attribute *Attribute) (*Attribute, error)
+	Delete(ctx context.Context, id int) (*Attribute, error)
+	Batch(ctx context.Context, req *BatchRequest[Attribute]) (*BatchResponse[Attribute], error)
+}
+
+type ProductReviewsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ProductReview, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]ProductReview) error) error
+	Get(ctx context.Context, id int) (*ProductReview, error)
+	Create(ctx context.Context, review *ProductReview) (*ProductReview, error)
+	Update(ctx context.Context, id int, review *ProductReview) (*ProductReview, error)
+	Delete(ctx context.Context, id int, force bool) (*ProductReview, error)
+	Batch(ctx context.Context, req *BatchRequest[ProductReview]) (*BatchResponse[ProductReview], error)
+	Approve(ctx context.Context, id int) (*ProductReview, error)
+	Spam(ctx context.Context, id int) (*ProductReview, error)
+	Trash(ctx context.Context, id int) (*ProductReview, error)
+}
+
+type AttributeTermsAPI interface {
+	List(ctx context.Context, attributeID int, params *ListParams) ([]AttributeTerm, *Response, error)
+	ListAll(ctx context.Context, attributeID int, params *ListParams, fn func([]AttributeTerm) error) error
+	Get(ctx context.Context, attributeID, id int) (*AttributeTerm, error)
+	Create(ctx context.Context, attributeID int, term *AttributeTerm) (*AttributeTerm, error)
+	Update(ctx context.Context, attributeID, id int, term *AttributeTerm) (*AttributeTerm, error)
+	Delete(ctx context.Context, attributeID, id int) (*AttributeTerm, error)
+	Batch(ctx context.Context, attributeID int, req *BatchRequest[AttributeTerm]) (*BatchResponse[AttributeTerm], error)
+}
+
+type ShippingZonesAPI interface {
+	List(ctx context.Context) ([]ShippingZone, error)
+	Get(ctx context.Context, id int) (*ShippingZone, error)
+	Create(ctx context.Context, zone *ShippingZone) (*ShippingZone, error)
+	Update(ctx context.Context, id int, zone *ShippingZone) (*ShippingZone, error)
+	Delete(ctx context.Context, id int) (*ShippingZone, error)
+	Locations(ctx context.Context, zoneID int) ([]ShippingZoneLocation, error)
+	UpdateLocations(ctx context.Context, zoneID int, locations []ShippingZoneLocation) ([]ShippingZoneLocation, error)
+	Methods(ctx context.Context, zoneID int) ([]ShippingZoneMethod, error)
+	GetMethod(ctx context.Context, zoneID, instanceID int) (*ShippingZoneMethod, error)
+	AddMethod(ctx context.Context, zoneID int, methodID string) (*ShippingZoneMethod, error)
+	UpdateMethod(ctx context.Context, zoneID, instanceID int, update *ShippingZoneMethodUpdate) (*ShippingZoneMethod, error)
+	DeleteMethod(ctx context.Context, zoneID, instanceID int) (*ShippingZoneMethod, error)
+}
+
+type ShippingMethodsAPI interface {
+	List(ctx context.Context) ([]ShippingMethod, error)
+	Get(ctx context.Context, id string) (*ShippingMethod, error)
+}
+
+type ShippingClassesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]ShippingClass, *Response, error)
+	Get(ctx context.Context, id int) (*ShippingClass, error)
+	Create(ctx context.Context, class *ShippingClass) (*ShippingClass, error)
+	Update(ctx context.Context, id int, class *ShippingClass) (*ShippingClass, error)
+	Delete(ctx context.Context, id int) (*ShippingClass, error)
+	Batch(ctx context.Context, req *BatchRequest[ShippingClass]) (*BatchResponse[ShippingClass], error)
+}
+
+type TaxRatesAPI interface {
+	List(ctx context.Context, params *ListParams) ([]TaxRate, *Response, error)
+	ListByClass(ctx context.Context, class string) ([]TaxRate, error)
+	Get(ctx context.Context, id int) (*TaxRate, error)
+	Create(ctx context.Context, rate *TaxRate) (*TaxRate, error)
+	Update(ctx context.Context, id int, rate *TaxRate) (*TaxRate, error)
+	Delete(ctx context.Context, id int) (*TaxRate, error)
+	Batch(ctx context.Context, req *BatchRequest[TaxRate]) (*BatchResponse[TaxRate], error)
+	Load(ctx context.Context, rates []TaxRate) ([]TaxRate, error)
+}
+
+type TaxClassesAPI interface {
+	List(ctx context.Context) ([]TaxClass, error)
+	Create(ctx context.Context, class *TaxClass) (*TaxClass, error)
+	Delete(ctx context.Context, slug string) (*TaxClass, error)
+}
+
+type PaymentGatewaysAPI interface {
+	List(ctx context.Context) ([]PaymentGateway, error)
+	Get(ctx context.Context, id string) (*PaymentGateway, error)
+	Update(ctx context.Context, id string, update *PaymentGatewayUpdate) (*PaymentGateway, error)
+	SetEnabled(ctx context.Context, id string, enabled bool) (*PaymentGateway, error)
+}
+
+type WebhooksAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Webhook, *Response, error)
+	ListByStatus(ctx context.Context, params *ListParams, status string) ([]Webhook, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Webhook) error) error
+	Get(ctx context.Context, id int) (*Webhook, error)
+	Create(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	Update(ctx context.Context, id int, webhook *Webhook) (*Webhook, error)
+	Delete(ctx context.Context, id int) (*Webhook, error)
+	Batch(ctx context.Context, req *BatchRequest[Webhook]) (*BatchResponse[Webhook], error)
+}
+
+type SettingsAPI interface {
+	Groups(ctx context.Context) ([]SettingsGroup, error)
+	List(ctx context.Context, group string) ([]SettingOption, error)
+	Get(ctx context.Context, group, id string) (*SettingOption, error)
+	Update(ctx context.Context, group, id string, value interface{}) (*SettingOption, error)
+	UpdateGroup(ctx context.Context, group string, values map[string]interface{}) ([]SettingOption, error)
+	TaxConfig(ctx context.Context) (*TaxConfig, error)
+	UpdateTaxConfig(ctx context.Context, config *TaxConfig) error
+}
+
+type SystemStatusAPI interface {
+	Get(ctx context.Context) (*SystemStatus, error)
+	Tools(ctx context.Context) ([]SystemStatusTool, error)
+	Tool(ctx context.Context, id string) (*SystemStatusTool, error)
+	RunTool(ctx context.Context, id string) (*SystemStatusTool, error)
+}
+
+type ReportsAPI interface {
+	List(ctx context.Context) ([]Report, error)
+	Sales(ctx context.Context, params *ReportParams) (*SalesReport, error)
+	TopSellers(ctx context.Context, params *ReportParams) ([]TopSeller, error)
+	Totals(ctx context.Context, resource string) ([]ReportTotal, error)
+}
+
+type AnalyticsAPI interface {
+	RevenueStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[RevenueTotals], error)
+	OrdersStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[OrdersTotals], error)
+	ProductsStats(ctx context.Context, params *AnalyticsParams) (*AnalyticsStats[ProductsTotals], error)
+	Leaderboards(ctx context.Context, params *AnalyticsParams) ([]Leaderboard, error)
+}
+
+type DataAPI interface {
+	Countries(ctx context.Context) ([]Country, error)
+	Country(ctx context.Context, code string) (*Country, error)
+	Currencies(ctx context.Context) ([]Currency, error)
+	Currency(ctx context.Context, code string) (*Currency, error)
+	CurrentCurrency(ctx context.Context) (*Currency, error)
+	Continents(ctx context.Context) ([]Continent, error)
+	Continent(ctx context.Context, code string) (*Continent, error)
+}
+
+type InventoryAPI interface {
+	AdjustStock(ctx context.Context, productID, delta int) (int, error)
+	SetStock(ctx context.Context, productID, qty int) (int, error)
+	AdjustVariationStock(ctx context.Context, productID, variationID, delta int) (int, error)
+	SetVariationStock(ctx context.Context, productID, variationID, qty int) (int, error)
+}
+
+type MediaAPI interface {
+	Upload(ctx context.Context, filename string, r io.Reader) (*Media, error)
+	UploadFile(ctx context.Context, path string) (*Media, error)
+	AttachToProduct(ctx context.Context, productID int, media *Media) (*Product, error)
+}
+
+type UsersAPI interface {
+	List(ctx context.Context, params *ListParams) ([]User, *Response, error)
+	Get(ctx context.Context, id int) (*User, error)
+	Me(ctx context.Context) (*User, error)
+	Create(ctx context.Context, user *User) (*User, error)
+	Update(ctx context.Context, id int, user *User) (*User, error)
+	SetRoles(ctx context.Context, id int, roles ...string) (*User, error)
+	Delete(ctx context.Context, id, reassign int) (*User, error)
+}
+
+type SubscriptionsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Subscription, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Subscription) error) error
+	Get(ctx context.Context, id int) (*Subscription, error)
+	Create(ctx context.Context, subscription *Subscription) (*Subscription, error)
+	Update(ctx context.Context, id int, subscription *Subscription) (*Subscription, error)
+	Delete(ctx context.Context, id int, force bool) (*Subscription, error)
+	SetStatus(ctx context.Context, id int, status string) (*Subscription, error)
+	Suspend(ctx context.Context, id int) (*Subscription, error)
+	Cancel(ctx context.Context, id int) (*Subscription, error)
+	Reactivate(ctx context.Context, id int) (*Subscription, error)
+	Orders(ctx context.Context, id int) ([]Order, error)
+	Notes(ctx context.Context, id int) ([]OrderNote, error)
+	AddNote(ctx context.Context, id int, note *OrderNote) (*OrderNote, error)
+	Statuses(ctx context.Context) (map[string]string, error)
+}
+
+type MembershipsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Membership, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Membership) error) error
+	ListByCustomer(ctx context.Context, customerID int) ([]Membership, error)
+	Get(ctx context.Context, id int) (*Membership, error)
+	Create(ctx context.Context, membership *Membership) (*Membership, error)
+	Update(ctx context.Context, id int, membership *Membership) (*Membership, error)
+	Delete(ctx context.Context, id int) (*Membership, error)
+	SetStatus(ctx context.Context, id int, status string) (*Membership, error)
+	Pause(ctx context.Context, id int) (*Membership, error)
+	Cancel(ctx context.Context, id int) (*Membership, error)
+	Reactivate(ctx context.Context, id int) (*Membership, error)
+}
+
+type MembershipPlansAPI interface {
+	List(ctx context.Context, params *ListParams) ([]MembershipPlan, *Response, error)
+	Get(ctx context.Context, id int) (*MembershipPlan, error)
+	Create(ctx context.Context, plan *MembershipPlan) (*MembershipPlan, error)
+	Update(ctx context.Context, id int, plan *MembershipPlan) (*MembershipPlan, error)
+	Delete(ctx context.Context, id int, force bool) (*MembershipPlan, error)
+}
+
+type BookingsAPI interface {
+	List(ctx context.Context, params *ListParams) ([]Booking, *Response, error)
+	ListAll(ctx context.Context, params *ListParams, fn func([]Booking) error) error
+	Get(ctx context.Context, id int) (*Booking, error)
+	Create(ctx context.Context, booking *Booking) (*Booking, error)
+	Slots(ctx context.Context, params *SlotParams) ([]Slot, error)
+	Resources(ctx context.Context, params *ListParams) ([]BookingResource, *Response, error)
+	Resource(ctx context.Context, id int) (*BookingResource, error)
+}
+
+var (
+	_ API                  = (*Client)(nil)
+	_ ProductsAPI          = (*ProductsService)(nil)
+	_ OrdersAPI            = (*OrdersService)(nil)
+	_ CustomersAPI         = (*CustomersService)(nil)
+	_ CouponsAPI           = (*CouponsService)(nil)
+	_ RefundsAPI           = (*RefundsService)(nil)
+	_ OrderNotesAPI        = (*OrderNotesService)(nil)
+	_ ProductVariationsAPI = (*ProductVariationsService)(nil)
+	_ ProductCategoriesAPI = (*ProductCategoriesService)(nil)
+	_ ProductTagsAPI       = (*ProductTagsService)(nil)
+	_ ProductAttributesAPI = (*ProductAttributesService)(nil)
+	_ ProductReviewsAPI    = (*ProductReviewsService)(nil)
+	_ AttributeTermsAPI    = (*AttributeTermsService)(nil)
+	_ ShippingZonesAPI     = (*ShippingZonesService)(nil)
+	_ ShippingMethodsAPI   = (*ShippingMethodsService)(nil)
+	_ ShippingClassesAPI   = (*ShippingClassesService)(nil)
+	_ TaxRatesAPI          = (*TaxRatesService)(nil)
+	_ TaxClassesAPI        = (*TaxClassesService)(nil)
+	_ PaymentGatewaysAPI   = (*PaymentGatewaysService)(nil)
+	_ WebhooksAPI          = (*WebhooksService)(nil)
+	_ SettingsAPI          = (*SettingsService)(nil)
+	_ SystemStatusAPI      = (*SystemStatusService)(nil)
+	_ ReportsAPI           = (*ReportsService)(nil)
+	_ AnalyticsAPI         = (*AnalyticsService)(nil)
+	_ DataAPI              = (*DataService)(nil)
+	_ InventoryAPI         = (*InventoryService)(nil)
+	_ MediaAPI             = (*MediaService)(nil)
+	_ UsersAPI             = (*UsersService)(nil)
+	_ SubscriptionsAPI     = (*SubscriptionsService)(nil)
+	_ MembershipsAPI       = (*MembershipsService)(nil)
+	_ MembershipPlansAPI   = (*MembershipPlansService)(nil)
+	_ BookingsAPI          = (*BookingsService)(nil)
+)
@@ -0,0 +1,47 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// fakeProducts overrides Get and leaves the other methods unimplemented,
+// as a downstream test would.
+type fakeProducts struct {
+	ProductsAPI
+	products map[int]*Product
+}
+
+func (f *fakeProducts) Get(ctx context.Context, id int) (*Product, error) {
+	if p, ok := f.products[id]; ok {
+		return p, nil
+	}
+	return nil, &APIError{StatusCode: 404, Code: "woocommerce_rest_product_invalid_id"}
+}
+
+func productName(ctx context.Context, products ProductsAPI, id int) string {
+	p, err := products.Get(ctx, id)
+	if err != nil {
+		return ""
+	}
+	return p.Name
+}
+
+func TestServiceInterfaces(t *testing.T) {
+	fake := &fakeProducts{products: map[int]*Product{794: {ID: 794, Name: "Premium Quality"}}}
+	ctx := context.Background()
+	if got := productName(ctx, fake, 794); got != "Premium Quality" {
+		t.Errorf("name = %q", got)
+	}
+	if got := productName(ctx, fake, 1); got != "" {
+		t.Errorf("missing product name = %q", got)
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":794,"name":"Premium Quality"}`))
+	})
+	if got := productName(ctx, client.Products, 794); got != "Premium Quality" {
+		t.Errorf("name from client = %q", got)
+	}
+}
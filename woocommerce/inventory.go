@@ -0,0 +1,128 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// maxStockAttempts is how often InventoryService retries a stock change
+// that raced with another writer.
+const maxStockAttempts = 3
+
+// ErrStockConflict is returned when a stock change kept racing with other
+// writers and was not applied, or when the quantity read back after the
+// write is not the one written. In the latter case the quantity read is
+// returned along with the error.
+var ErrStockConflict = errors.New("Stock was modified concurrently")
+
+// InventoryService changes stock quantities without clobbering changes
+// made by other systems. Each change reads the current quantity, and
+// reads it again just before writing; if the quantity or date_modified
+// moved in between, the change is recomputed and retried. After the write
+// the quantity is read once more, and a change that was overwritten or
+// combined with a concurrent write fails with ErrStockConflict.
+//
+// WooCommerce has no conditional writes, so a concurrent write can still
+// be lost; the check after the write makes sure it is reported rather than
+// silent.
+type InventoryService service
+
+// stockState is what a stock change checks for concurrent writes.
+type stockState struct {
+	quantity int
+	modified WCTime
+}
+
+// AdjustStock adds delta, which may be negative, to the stock of the
+// product and returns the new quantity. The product must manage stock.
+func (s *InventoryService) AdjustStock(ctx context.Context, productID, delta int) (int, error) {
+	return s.change(ctx, productID, 0, func(qty int) int { return qty + delta }, true)
+}
+
+// SetStock sets the stock of the product to qty, enabling stock
+// management if needed, and returns the new quantity.
+func (s *InventoryService) SetStock(ctx context.Context, productID, qty int) (int, error) {
+	return s.change(ctx, productID, 0, func(int) int { return qty }, false)
+}
+
+// AdjustVariationStock is AdjustStock for a variation of a variable
+// product.
+func (s *InventoryService) AdjustVariationStock(ctx context.Context, productID, variationID, delta int) (int, error) {
+	return s.change(ctx, productID, variationID, func(qty int) int { return qty + delta }, true)
+}
+
+// SetVariationStock is SetStock for a variation of a variable product.
+func (s *InventoryService) SetVariationStock(ctx context.Context, productID, variationID, qty int) (int, error) {
+	return s.change(ctx, productID, variationID, func(int) int { return qty }, false)
+}
+
+// change applies next to the stock of the product, or of its variation if
+// variationID is not zero. If managed is set the stock must already be
+// managed.
+func (s *InventoryService) change(ctx context.Context, productID, variationID int, next func(int) int, managed bool) (int, error) {
+	for attempt := 0; attempt < maxStockAttempts; attempt++ {
+		before, manages, err := s.read(ctx, productID, variationID)
+		if err != nil {
+			return 0, err
+		}
+		if managed && !manages {
+			return 0, fmt.Errorf("Stock is not managed for product %d", productID)
+		}
+		qty := next(before.quantity)
+		check, _, err := s.read(ctx, productID, variationID)
+		if err != nil {
+			return 0, err
+		}
+		if check != before {
+			continue
+		}
+		if err := s.write(ctx, productID, variationID, qty); err != nil {
+			return 0, err
+		}
+		// The write may have raced with another one, which cannot be
+		// undone; the change is not retried, since the other write may
+		// already include it.
+		after, _, err := s.read(ctx, productID, variationID)
+		if err != nil {
+			return 0, err
+		}
+		if after.quantity != qty {
+			return after.quantity, fmt.Errorf("%w: product %d has %d in stock after writing %d", ErrStockConflict, productID, after.quantity, qty)
+		}
+		return qty, nil
+	}
+	return 0, ErrStockConflict
+}
+
+func (s *InventoryService) read(ctx context.Context, productID, variationID int) (stockState, bool, error) {
+	var qty *int
+	var state stockState
+	var manages bool
+	if variationID == 0 {
+		p, err := s.client.Products.Get(ctx, productID)
+		if err != nil {
+			return state, false, err
+		}
+		qty, state.modified, manages = p.StockQuantity, p.DateModifiedGMT, isTrue(p.ManageStock)
+	} else {
+		v, err := s.client.ProductVariations.Get(ctx, productID, variationID)
+		if err != nil {
+			return state, false, err
+		}
+		qty, state.modified, manages = v.StockQuantity, v.DateModifiedGMT, isTrue(v.ManageStock)
+	}
+	if qty != nil {
+		state.quantity = *qty
+	}
+	return state, manages, nil
+}
+
+func (s *InventoryService) write(ctx context.Context, productID, variationID, qty int) error {
+	if variationID == 0 {
+		_, err := s.client.Products.Update(ctx, productID, &Product{ManageStock: Bool(true), StockQuantity: &qty})
+		return err
+	}
+	_, err := s.client.ProductVariations.Update(ctx, productID, variationID, &ProductVariation{ManageStock: Bool(true), StockQuantity: &qty})
+	return err
+}
@@ -0,0 +1,95 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestInventoryAdjustStock(t *testing.T) {
+	stock, gets, puts := 10, 0, 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gets++
+			if gets == 2 {
+				// Another system sells two between our reads.
+				stock -= 2
+			}
+		case http.MethodPut:
+			puts++
+			var p Product
+			json.NewDecoder(r.Body).Decode(&p)
+			stock = *p.StockQuantity
+		}
+		fmt.Fprintf(w, `{"id":794,"manage_stock":true,"stock_quantity":%d,"date_modified_gmt":"2026-10-15T06:00:0%d"}`, stock, puts)
+	})
+	qty, err := client.Inventory.AdjustStock(context.Background(), 794, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qty != 13 || stock != 13 || gets != 5 || puts != 1 {
+		t.Errorf("qty = %d, stock = %d after %d reads and %d writes", qty, stock, gets, puts)
+	}
+}
+
+func TestInventoryConflict(t *testing.T) {
+	gets := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s", r.Method)
+		}
+		gets++
+		fmt.Fprintf(w, `{"id":22,"manage_stock":true,"stock_quantity":%d}`, gets)
+	})
+	_, err := client.Inventory.AdjustVariationStock(context.Background(), 22, 733, -1)
+	if !errors.Is(err, ErrStockConflict) || gets != 2*maxStockAttempts {
+		t.Errorf("err = %v after %d reads", err, gets)
+	}
+}
+
+func TestInventorySetStock(t *testing.T) {
+	var sent Product
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			json.NewDecoder(r.Body).Decode(&sent)
+		}
+		if sent.StockQuantity != nil {
+			w.Write([]byte(`{"id":794,"manage_stock":true,"stock_quantity":7}`))
+			return
+		}
+		w.Write([]byte(`{"id":794,"manage_stock":false}`))
+	})
+	ctx := context.Background()
+	if _, err := client.Inventory.AdjustStock(ctx, 794, 1); err == nil {
+		t.Error("expected error adjusting unmanaged stock")
+	}
+	qty, err := client.Inventory.SetStock(ctx, 794, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qty != 7 || !isTrue(sent.ManageStock) || *sent.StockQuantity != 7 {
+		t.Errorf("qty = %d, sent %+v", qty, sent)
+	}
+}
+
+func TestInventoryLostWrite(t *testing.T) {
+	stock, puts := 10, 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+			var p Product
+			json.NewDecoder(r.Body).Decode(&p)
+			// Another system writes its own count right after ours.
+			stock = *p.StockQuantity - 100
+		}
+		fmt.Fprintf(w, `{"id":794,"manage_stock":true,"stock_quantity":%d}`, stock)
+	})
+	qty, err := client.Inventory.AdjustStock(context.Background(), 794, -1)
+	if !errors.Is(err, ErrStockConflict) || qty != -91 || puts != 1 {
+		t.Errorf("qty = %d, err = %v after %d writes", qty, err, puts)
+	}
+}
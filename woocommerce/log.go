@@ -0,0 +1,90 @@
+package woocommerce
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redactParams returns params encoded with the values of credential-like
+// parameters replaced, so they never reach the logs.
+func redactParams(params url.Values) string {
+	if len(params) == 0 {
+		return ""
+	}
+	redacted := url.Values{}
+	for k, v := range params {
+		key := strings.ToLower(k)
+		if strings.HasPrefix(key, "oauth_") || strings.HasPrefix(key, "consumer_") ||
+			strings.Contains(key, "secret") || strings.Contains(key, "password") || strings.Contains(key, "token") {
+			redacted[k] = []string{"REDACTED"}
+			continue
+		}
+		redacted[k] = v
+	}
+	return redacted.Encode()
+}
+
+func (c *Client) logAttempt(ctx context.Context, method, endpoint string, params url.Values, attempt, status int, elapsed time.Duration, err error) {
+	if c.logger == nil {
+		return
+	}
+	level := slog.LevelDebug
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("endpoint", endpoint),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", elapsed),
+	}
+	if query := redactParams(params); query != "" {
+		attrs = append(attrs, slog.String("query", query))
+	}
+	if status != 0 {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	if err != nil {
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	c.logger.LogAttrs(ctx, level, "woocommerce request", attrs...)
+}
+
+func (c *Client) logRetry(ctx context.Context, method, endpoint string, attempt int, delay time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.LogAttrs(ctx, slog.LevelInfo, "woocommerce retry",
+		slog.String("method", method),
+		slog.String("endpoint", endpoint),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("delay", delay),
+	)
+}
+
+func (c *Client) logMaintenance(ctx context.Context, method, endpoint string, pause, waited time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.LogAttrs(ctx, slog.LevelWarn, "woocommerce maintenance",
+		slog.String("method", method),
+		slog.String("endpoint", endpoint),
+		slog.Duration("pause", pause),
+		slog.Duration("waited", waited),
+	)
+}
+
+func (c *Client) logDeprecations(ctx context.Context, method, endpoint string, header http.Header) {
+	if c.logger == nil {
+		return
+	}
+	for _, notice := range deprecations(header) {
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "woocommerce deprecation",
+			slog.String("method", method),
+			slog.String("endpoint", endpoint),
+			slog.String("notice", notice),
+		)
+	}
+}
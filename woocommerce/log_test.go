@@ -0,0 +1,66 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLogging(t *testing.T) {
+	var calls int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("[]"))
+	})
+	var buf bytes.Buffer
+	client, err := NewClient(srv.URL, "ck_live", "cs_live", &Option{
+		Retry:      &RetryPolicy{MaxAttempts: 2, BackoffBase: time.Millisecond},
+		LogHandler: slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "products", url.Values{"sku": {"A1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+
+	out := buf.String()
+	for _, want := range []string{
+		"level=WARN msg=\"woocommerce request\" method=GET endpoint=products attempt=1",
+		`query="sku=A1" status=503`,
+		"level=INFO msg=\"woocommerce retry\" method=GET endpoint=products attempt=2",
+		"level=DEBUG msg=\"woocommerce request\" method=GET endpoint=products attempt=2",
+		"status=200",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "cs_live") || strings.Contains(out, "ck_live") {
+		t.Errorf("log output contains credentials:\n%s", out)
+	}
+}
+
+func TestRedactParams(t *testing.T) {
+	got := redactParams(url.Values{
+		"consumer_secret": {"cs"},
+		"oauth_signature": {"sig"},
+		"api_token":       {"tok"},
+		"status":          {"processing"},
+	})
+	want := "api_token=REDACTED&consumer_secret=REDACTED&oauth_signature=REDACTED&status=processing"
+	if got != want {
+		t.Errorf("redactParams = %q, want %q", got, want)
+	}
+}
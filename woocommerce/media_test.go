@@ -0,0 +1,69 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMediaUpload(t *testing.T) {
+	var filename, content, user string
+	var images []ProductImage
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		user, _, _ = r.BasicAuth()
+		switch r.URL.Path {
+		case "/wp-json/wp/v2/media":
+			file, header, err := r.FormFile("file")
+			if err != nil || header.Size == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"code":"rest_upload_no_data","message":"No data supplied."}`))
+				return
+			}
+			data, _ := io.ReadAll(file)
+			filename, content = header.Filename, string(data)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":612,"source_url":"https://example.com/wp-content/uploads/hoodie.jpg","media_type":"image","mime_type":"image/jpeg"}`))
+		case "/wp-json/wc/v3/products/794":
+			if r.Method == http.MethodPut {
+				var p Product
+				json.NewDecoder(r.Body).Decode(&p)
+				images = p.Images
+			}
+			w.Write([]byte(`{"id":794,"images":[{"id":611,"src":"https://example.com/a.jpg"}]}`))
+		}
+	})
+	client, err := NewClient(srv.URL, "", "", &Option{Auth: &ApplicationPasswordAuth{Username: "shop", Password: "abcd efgh"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "hoodie.jpg")
+	if err := os.WriteFile(path, []byte("JPEG"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	media, err := client.Media.UploadFile(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if media.ID != 612 || !strings.HasSuffix(media.SourceURL, "hoodie.jpg") {
+		t.Errorf("media = %+v", media)
+	}
+	if filename != "hoodie.jpg" || content != "JPEG" || user != "shop" {
+		t.Errorf("uploaded %q = %q as %q", filename, content, user)
+	}
+	if _, err := client.Media.AttachToProduct(ctx, 794, media); err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 || images[0].ID != 611 || images[1].ID != 612 {
+		t.Errorf("images = %+v", images)
+	}
+
+	if _, err := client.Media.Upload(ctx, "empty.jpg", strings.NewReader("")); err == nil {
+		t.Error("expected error for rejected upload")
+	}
+}
@@ -0,0 +1,163 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// MembershipsService manages the user memberships at /memberships/members,
+// added by the WooCommerce Memberships extension. Creating a membership
+// grants a customer access to a plan, e.g. after a payment taken outside
+// the store.
+type MembershipsService service
+
+// MembershipPlansService manages /memberships/plans.
+type MembershipPlansService service
+
+// Membership status values.
+const (
+	MembershipStatusActive        = "active"
+	MembershipStatusComplimentary = "complimentary"
+	MembershipStatusDelayed       = "delayed"
+	MembershipStatusFreeTrial     = "free_trial"
+	MembershipStatusPaused        = "paused"
+	MembershipStatusPending       = "pending"
+	MembershipStatusExpired       = "expired"
+	MembershipStatusCancelled     = "cancelled"
+)
+
+type Membership struct {
+	ID               int    `json:"id,omitempty"`
+	CustomerID       int    `json:"customer_id,omitempty"`
+	PlanID           int    `json:"plan_id,omitempty"`
+	Status           string `json:"status,omitempty"`
+	OrderID          int    `json:"order_id,omitempty"`
+	ProductID        int    `json:"product_id,omitempty"`
+	SubscriptionID   int    `json:"subscription_id,omitempty"`
+	DateCreated      WCTime `json:"date_created,omitzero"`
+	DateCreatedGMT   WCTime `json:"date_created_gmt,omitzero"`
+	StartDate        WCTime `json:"start_date,omitzero"`
+	StartDateGMT     WCTime `json:"start_date_gmt,omitzero"`
+	EndDate          WCTime `json:"end_date,omitzero"`
+	EndDateGMT       WCTime `json:"end_date_gmt,omitzero"`
+	PausedDate       WCTime `json:"paused_date,omitzero"`
+	PausedDateGMT    WCTime `json:"paused_date_gmt,omitzero"`
+	CancelledDate    WCTime `json:"cancelled_date,omitzero"`
+	CancelledDateGMT WCTime `json:"cancelled_date_gmt,omitzero"`
+	ViewURL          string `json:"view_url,omitempty"`
+	MetaData         Meta   `json:"meta_data,omitempty"`
+}
+
+type MembershipPlan struct {
+	ID               int    `json:"id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Slug             string `json:"slug,omitempty"`
+	Status           string `json:"status,omitempty"`
+	AccessMethod     string `json:"access_method,omitempty"`
+	AccessProductIDs []int  `json:"access_product_ids,omitempty"`
+	AccessLengthType string `json:"access_length_type,omitempty"`
+	AccessLength     string `json:"access_length,omitempty"`
+	AccessStartDate  WCTime `json:"access_start_date,omitzero"`
+	AccessEndDate    WCTime `json:"access_end_date,omitzero"`
+	DateCreated      WCTime `json:"date_created,omitzero"`
+	DateCreatedGMT   WCTime `json:"date_created_gmt,omitzero"`
+	DateModified     WCTime `json:"date_modified,omitzero"`
+	DateModifiedGMT  WCTime `json:"date_modified_gmt,omitzero"`
+	MetaData         Meta   `json:"meta_data,omitempty"`
+}
+
+func (s *MembershipsService) List(ctx context.Context, params *ListParams) ([]Membership, *Response, error) {
+	var memberships []Membership
+	resp, err := s.client.list(ctx, "memberships/members", params, &memberships)
+	return memberships, resp, err
+}
+
+// ListAll calls fn with every page of memberships matching params.
+func (s *MembershipsService) ListAll(ctx context.Context, params *ListParams, fn func([]Membership) error) error {
+	return listAll(ctx, s.client, "memberships/members", params, fn)
+}
+
+// ListByCustomer returns the memberships of a customer.
+func (s *MembershipsService) ListByCustomer(ctx context.Context, customerID int) ([]Membership, error) {
+	memberships, _, err := s.List(ctx, NewListParams().Set("customer", strconv.Itoa(customerID)))
+	return memberships, err
+}
+
+func (s *MembershipsService) Get(ctx context.Context, id int) (*Membership, error) {
+	membership := new(Membership)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("memberships", "members", id), nil, nil, membership)
+	return membership, err
+}
+
+// Create grants a customer a membership. CustomerID and PlanID are
+// required.
+func (s *MembershipsService) Create(ctx context.Context, membership *Membership) (*Membership, error) {
+	created := new(Membership)
+	_, err := s.client.call(ctx, http.MethodPost, "memberships/members", nil, membership, created)
+	return created, err
+}
+
+func (s *MembershipsService) Update(ctx context.Context, id int, membership *Membership) (*Membership, error) {
+	updated := new(Membership)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("memberships", "members", id), nil, membership, updated)
+	return updated, err
+}
+
+// Delete deletes a membership. Memberships cannot be trashed, so the
+// request is always forced.
+func (s *MembershipsService) Delete(ctx context.Context, id int) (*Membership, error) {
+	deleted := new(Membership)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("memberships", "members", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+// SetStatus moves a membership to status, one of the MembershipStatus
+// values.
+func (s *MembershipsService) SetStatus(ctx context.Context, id int, status string) (*Membership, error) {
+	return s.Update(ctx, id, &Membership{Status: status})
+}
+
+// Pause suspends a membership's access until it is reactivated.
+func (s *MembershipsService) Pause(ctx context.Context, id int) (*Membership, error) {
+	return s.SetStatus(ctx, id, MembershipStatusPaused)
+}
+
+func (s *MembershipsService) Cancel(ctx context.Context, id int) (*Membership, error) {
+	return s.SetStatus(ctx, id, MembershipStatusCancelled)
+}
+
+// Reactivate makes a paused or cancelled membership active again.
+func (s *MembershipsService) Reactivate(ctx context.Context, id int) (*Membership, error) {
+	return s.SetStatus(ctx, id, MembershipStatusActive)
+}
+
+func (s *MembershipPlansService) List(ctx context.Context, params *ListParams) ([]MembershipPlan, *Response, error) {
+	var plans []MembershipPlan
+	resp, err := s.client.list(ctx, "memberships/plans", params, &plans)
+	return plans, resp, err
+}
+
+func (s *MembershipPlansService) Get(ctx context.Context, id int) (*MembershipPlan, error) {
+	plan := new(MembershipPlan)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("memberships", "plans", id), nil, nil, plan)
+	return plan, err
+}
+
+func (s *MembershipPlansService) Create(ctx context.Context, plan *MembershipPlan) (*MembershipPlan, error) {
+	created := new(MembershipPlan)
+	_, err := s.client.call(ctx, http.MethodPost, "memberships/plans", nil, plan, created)
+	return created, err
+}
+
+func (s *MembershipPlansService) Update(ctx context.Context, id int, plan *MembershipPlan) (*MembershipPlan, error) {
+	updated := new(MembershipPlan)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("memberships", "plans", id), nil, plan, updated)
+	return updated, err
+}
+
+func (s *MembershipPlansService) Delete(ctx context.Context, id int, force bool) (*MembershipPlan, error) {
+	deleted := new(MembershipPlan)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("memberships", "plans", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
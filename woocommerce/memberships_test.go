@@ -0,0 +1,80 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+const membershipJSON = `{"id":410,"customer_id":25,"plan_id":7,"status":"active","order_id":0,"start_date_gmt":"2024-03-01T00:00:00","end_date_gmt":null,"view_url":"https://example.com/my-account/members-area/7/"}`
+
+func TestMemberships(t *testing.T) {
+	var method, path string
+	var query url.Values
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, query, body = r.Method, r.URL.Path, r.URL.Query(), nil
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.Method == http.MethodGet && path == "/wp-json/wc/v3/memberships/members" {
+			w.Write([]byte("[" + membershipJSON + "]"))
+			return
+		}
+		w.Write([]byte(membershipJSON))
+	})
+	ctx := context.Background()
+
+	memberships, err := client.Memberships.ListByCustomer(ctx, 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("customer") != "25" || len(memberships) != 1 || memberships[0].PlanID != 7 || !memberships[0].EndDateGMT.IsZero() {
+		t.Errorf("memberships = %+v, query %v", memberships, query)
+	}
+
+	if _, err := client.Memberships.Create(ctx, &Membership{CustomerID: 25, PlanID: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["customer_id"] != 25.0 || body["plan_id"] != 7.0 || len(body) != 2 {
+		t.Errorf("create sent %s %v", method, body)
+	}
+
+	for _, tc := range []struct {
+		change func(context.Context, int) (*Membership, error)
+		status string
+	}{
+		{client.Memberships.Pause, "paused"},
+		{client.Memberships.Reactivate, "active"},
+		{client.Memberships.Cancel, "cancelled"},
+	} {
+		if _, err := tc.change(ctx, 410); err != nil {
+			t.Fatal(err)
+		}
+		if method != http.MethodPut || path != "/wp-json/wc/v3/memberships/members/410" || body["status"] != tc.status {
+			t.Errorf("%s sent %s %s %v", tc.status, method, path, body)
+		}
+	}
+
+	if _, err := client.Memberships.Delete(ctx, 410); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || query.Get("force") != "true" {
+		t.Errorf("delete sent %s %v", method, query)
+	}
+}
+
+func TestMembershipPlans(t *testing.T) {
+	var path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`[{"id":7,"name":"Gold","slug":"gold","status":"publish","access_method":"purchase","access_product_ids":[794],"access_length_type":"specific","access_length":"1 years"}]`))
+	})
+	plans, _, err := client.MembershipPlans.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/memberships/plans" || len(plans) != 1 || plans[0].AccessProductIDs[0] != 794 || plans[0].AccessLength != "1 years" {
+		t.Errorf("plans = %+v from %s", plans, path)
+	}
+}
@@ -0,0 +1,114 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// MetaData is an entry of the meta_data array carried by most resources.
+type MetaData struct {
+	ID    int         `json:"id,omitempty"`
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// Decode decodes the value into v, e.g. a struct for a plugin's nested
+// JSON meta.
+func (d MetaData) Decode(v interface{}) error {
+	data, err := json.Marshal(d.Value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Meta is the meta_data array of a resource. Its methods let plugin data
+// be read and changed by key; send the resource in an update to save the
+// changes.
+type Meta []MetaData
+
+// Get returns the first entry with key.
+func (m Meta) Get(key string) (MetaData, bool) {
+	for _, d := range m {
+		if d.Key == key {
+			return d, true
+		}
+	}
+	return MetaData{}, false
+}
+
+// String returns the value of key formatted as a string, which is how
+// WordPress stores scalar meta, or "" if there is no such entry.
+func (m Meta) String(key string) string {
+	d, ok := m.Get(key)
+	if !ok || d.Value == nil {
+		return ""
+	}
+	switch v := d.Value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Float64 returns the value of key as a number, parsing it if it is
+// stored as a string. The boolean is false if there is no such entry or
+// the value is not numeric.
+func (m Meta) Float64(key string) (float64, bool) {
+	d, ok := m.Get(key)
+	if !ok {
+		return 0, false
+	}
+	switch v := d.Value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Decode decodes the value of key into v. It returns false if there is no
+// such entry.
+func (m Meta) Decode(key string, v interface{}) (bool, error) {
+	d, ok := m.Get(key)
+	if !ok {
+		return false, nil
+	}
+	return true, d.Decode(v)
+}
+
+// Set sets the value of key, updating the first entry with key so that its
+// ID is kept, or adding an entry.
+func (m *Meta) Set(key string, value interface{}) {
+	for i := range *m {
+		if (*m)[i].Key == key {
+			(*m)[i].Value = value
+			return
+		}
+	}
+	*m = append(*m, MetaData{Key: key, Value: value})
+}
+
+// Delete removes every entry with key. Saved entries are kept with a null
+// value, which is how WooCommerce is told to delete them; unsaved entries
+// are dropped.
+func (m *Meta) Delete(key string) {
+	kept := (*m)[:0]
+	for _, d := range *m {
+		if d.Key != key {
+			kept = append(kept, d)
+		} else if d.ID != 0 {
+			d.Value = nil
+			kept = append(kept, d)
+		}
+	}
+	*m = kept
+}
@@ -0,0 +1,55 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMeta(t *testing.T) {
+	var product Product
+	data := `{"meta_data":[
+		{"id":1,"key":"_erp_id","value":"ERP-1"},
+		{"id":2,"key":"_weight_g","value":"250"},
+		{"id":3,"key":"_points","value":12.5},
+		{"id":4,"key":"_bundle","value":{"items":[{"sku":"A","qty":2}]}}
+	]}`
+	if err := json.Unmarshal([]byte(data), &product); err != nil {
+		t.Fatal(err)
+	}
+	meta := product.MetaData
+	if meta.String("_erp_id") != "ERP-1" || meta.String("_points") != "12.5" || meta.String("_missing") != "" {
+		t.Errorf("strings = %q, %q", meta.String("_erp_id"), meta.String("_points"))
+	}
+	if f, ok := meta.Float64("_weight_g"); !ok || f != 250 {
+		t.Errorf("_weight_g = %v, %v", f, ok)
+	}
+	if _, ok := meta.Float64("_erp_id"); ok {
+		t.Error("non-numeric meta read as a number")
+	}
+	var bundle struct {
+		Items []struct {
+			SKU string `json:"sku"`
+			Qty int    `json:"qty"`
+		} `json:"items"`
+	}
+	if ok, err := meta.Decode("_bundle", &bundle); !ok || err != nil || len(bundle.Items) != 1 || bundle.Items[0].Qty != 2 {
+		t.Errorf("_bundle = %+v, %v, %v", bundle, ok, err)
+	}
+}
+
+func TestMetaSetDelete(t *testing.T) {
+	product := &Product{MetaData: Meta{{ID: 1, Key: "_erp_id", Value: "ERP-1"}}}
+	product.MetaData.Set("_erp_id", "ERP-2")
+	product.MetaData.Set("_source", "import")
+	product.MetaData.Set("_draft", true)
+	product.MetaData.Delete("_draft")
+	product.MetaData.Delete("_erp_id")
+
+	data, err := json.Marshal(&Product{MetaData: product.MetaData})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"meta_data":[{"id":1,"key":"_erp_id","value":null},{"key":"_source","value":"import"}]}` {
+		t.Errorf("meta_data = %s", data)
+	}
+}
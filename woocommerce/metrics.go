@@ -0,0 +1,13 @@
+package woocommerce
+
+import "time"
+
+// Metrics receives measurements for every request attempt and retry. The
+// route is the endpoint with numeric ids replaced, as returned by
+// RequestRoute. status is 0 when no response was received.
+//
+// See the promwc package for a Prometheus implementation.
+type Metrics interface {
+	ObserveRequest(method, route string, status int, duration time.Duration)
+	ObserveRetry(method, route string)
+}
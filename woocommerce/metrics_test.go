@@ -0,0 +1,53 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeMetrics struct {
+	requests []string
+	retries  []string
+}
+
+func (m *fakeMetrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	m.requests = append(m.requests, fmt.Sprintf("%s %s %d", method, route, status))
+}
+
+func (m *fakeMetrics) ObserveRetry(method, route string) {
+	m.retries = append(m.retries, method+" "+route)
+}
+
+func TestMetrics(t *testing.T) {
+	var calls int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	metrics := &fakeMetrics{}
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{
+		Metrics: metrics,
+		Retry:   &RetryPolicy{MaxAttempts: 2, BackoffBase: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "orders/42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+	if got := fmt.Sprint(metrics.requests); got != "[GET orders/{id} 502 GET orders/{id} 200]" {
+		t.Errorf("requests = %s", got)
+	}
+	if got := fmt.Sprint(metrics.retries); got != "[GET orders/{id}]" {
+		t.Errorf("retries = %s", got)
+	}
+}
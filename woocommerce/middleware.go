@@ -0,0 +1,59 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type routeKey struct{}
+
+// RequestRoute returns the endpoint a request made by the client was sent
+// to, with numeric ids replaced by "{id}" (e.g. "orders/{id}/notes"), for
+// use as a low-cardinality label in middleware. It returns "" for requests
+// not made by a Client.
+func RequestRoute(req *http.Request) string {
+	route, _ := req.Context().Value(routeKey{}).(string)
+	return route
+}
+
+func withRoute(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, routeKey{}, routeOf(endpoint))
+}
+
+func routeOf(endpoint string) string {
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	for i, seg := range segments {
+		if seg != "" && strings.Trim(seg, "0123456789") == "" {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// RoundTripFunc sends a single signed request. It implements
+// http.RoundTripper.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the sending of requests, for logging, metrics, caching,
+// header injection and the like. It sees each attempt after authentication
+// has been applied.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middlewares to the client. The first middleware added is the
+// outermost one. Use must not be called concurrently with requests.
+func (c *Client) Use(mw ...Middleware) {
+	c.middlewares = append(c.middlewares, mw...)
+}
+
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.rawClient.Do)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next(req)
+}
@@ -0,0 +1,97 @@
+package woocommerce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("X-Trace")))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	tag := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+">")
+				req.Header.Add("X-Trace", name)
+				resp, err := next(req)
+				calls = append(calls, "<"+name)
+				return resp, err
+			}
+		}
+	}
+	client.Use(tag("a"), tag("b"))
+	resp, err := client.Get(context.Background(), "orders", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp)
+	resp.Close()
+	if string(body) != "a" {
+		t.Errorf("first X-Trace at server = %q, want a", body)
+	}
+	if got := strings.Join(calls, " "); got != "a> b> <b <a" {
+		t.Errorf("call order = %q", got)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	client, err := NewClient("http://example.invalid", "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"X-Wp-Total": {"3"}},
+				Body:       io.NopCloser(strings.NewReader(`[]`)),
+				Request:    req,
+			}, nil
+		}
+	})
+	resp, err := client.Get(context.Background(), "products", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+	if resp.Total != 3 {
+		t.Errorf("Total = %d, want 3", resp.Total)
+	}
+}
+
+func TestRequestRoute(t *testing.T) {
+	client, err := NewClient("http://example.invalid", "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var routes []string
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			routes = append(routes, RequestRoute(req))
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+		}
+	})
+	for _, endpoint := range []string{"products", "orders/123/notes/9", "products/categories/shoes"} {
+		resp, err := client.Get(context.Background(), endpoint, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Close()
+	}
+	if got := strings.Join(routes, ","); got != "products,orders/{id}/notes/{id},products/categories/shoes" {
+		t.Errorf("routes = %q", got)
+	}
+	if RequestRoute(httptest.NewRequest("GET", "/", nil)) != "" {
+		t.Error("route for foreign request should be empty")
+	}
+}
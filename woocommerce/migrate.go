@@ -0,0 +1,216 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// MigrateProducts copies the products matching params from src to dst,
+// e.g. from a staging store to production, creating them on dst in
+// batches. It returns the number of products created, and the errors of
+// the products that failed joined together; it stops at the first failed
+// request.
+//
+// Products are created without their IDs, which dst assigns, and without
+// read-only fields such as prices, ratings, sales and dates. Categories
+// and tags are matched to dst's by slug, and created, without a parent,
+// where missing; shipping classes are matched and created by slug the
+// same way. Global attributes are matched to dst's by name and become
+// custom attributes where dst has none of that name. Images are
+// sideloaded by dst from their source URLs. References to other products,
+// such as variations, grouped products, upsells and cross-sells, are
+// dropped, as their IDs differ between stores; variations must be copied
+// separately.
+func MigrateProducts(ctx context.Context, src, dst *Client, params *ListParams) (int, error) {
+	m := &migration{ctx: ctx, dst: dst, categories: map[string]int{}, tags: map[string]int{}}
+	err := dst.ProductCategories.ListAll(ctx, nil, func(categories []ProductCategory) error {
+		for _, category := range categories {
+			m.categories[category.Slug] = category.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = dst.ProductTags.ListAll(ctx, nil, func(tags []ProductTag) error {
+		for _, tag := range tags {
+			m.tags[tag.Slug] = tag.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = src.Products.Stream(ctx, params, func(product *Product) error {
+		if err := m.add(product); err != nil {
+			return err
+		}
+		if len(m.pending) == maxBatchSize {
+			return m.flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = m.flush()
+	}
+	if err != nil {
+		return m.migrated, err
+	}
+	return m.migrated, errors.Join(m.errs...)
+}
+
+type migration struct {
+	ctx        context.Context
+	dst        *Client
+	categories map[string]int
+	tags       map[string]int
+	// attributes and classes map dst's global attributes by name and
+	// shipping classes by slug; they are loaded when first needed.
+	attributes map[string]int
+	classes    map[string]int
+	pending    []Product
+	migrated   int
+	errs       []error
+}
+
+func (m *migration) add(product *Product) error {
+	p := *product
+	p.ID = 0
+	p.ParentID = 0
+	p.Permalink = ""
+	p.DateCreated, p.DateCreatedGMT = WCTime{}, WCTime{}
+	p.DateModified, p.DateModifiedGMT = WCTime{}, WCTime{}
+	p.Price = Decimal{}
+	p.PriceHTML = ""
+	p.OnSale, p.Purchasable = false, false
+	p.TotalSales = 0
+	p.BackordersAllowed, p.Backordered = false, false
+	p.ShippingRequired, p.ShippingTaxable = false, false
+	p.AverageRating, p.RatingCount = "", 0
+	p.Variations = nil
+	p.GroupedProducts = nil
+	p.RelatedIDs = nil
+	p.UpsellIDs = nil
+	p.CrossSellIDs = nil
+	p.Images = make([]ProductImage, len(product.Images))
+	for i, image := range product.Images {
+		p.Images[i] = ProductImage{Src: image.Src, Name: image.Name, Alt: image.Alt}
+	}
+	p.MetaData = make(Meta, len(product.MetaData))
+	for i, meta := range product.MetaData {
+		p.MetaData[i] = MetaData{Key: meta.Key, Value: meta.Value}
+	}
+	var err error
+	if p.Categories, err = m.terms(product.Categories, m.categories, func(ref ProductTermRef) (int, error) {
+		category, err := m.dst.ProductCategories.Create(m.ctx, &ProductCategory{Name: ref.Name, Slug: ref.Slug})
+		return category.ID, err
+	}); err != nil {
+		return err
+	}
+	if p.Tags, err = m.terms(product.Tags, m.tags, func(ref ProductTermRef) (int, error) {
+		tag, err := m.dst.ProductTags.Create(m.ctx, &ProductTag{Name: ref.Name, Slug: ref.Slug})
+		return tag.ID, err
+	}); err != nil {
+		return err
+	}
+	if p.Attributes, err = m.mapAttributes(product.Attributes); err != nil {
+		return err
+	}
+	if p.DefaultAttributes, err = m.mapAttributes(product.DefaultAttributes); err != nil {
+		return err
+	}
+	p.ShippingClassID = 0
+	if p.ShippingClass != "" {
+		if err := m.shippingClass(p.ShippingClass); err != nil {
+			return err
+		}
+	}
+	m.pending = append(m.pending, p)
+	return nil
+}
+
+// mapAttributes replaces the IDs of global attributes with dst's, matched
+// by name, or with 0, which makes them custom attributes.
+func (m *migration) mapAttributes(attributes []ProductAttribute) ([]ProductAttribute, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+	mapped := make([]ProductAttribute, len(attributes))
+	for i, attribute := range attributes {
+		if attribute.ID != 0 {
+			if m.attributes == nil {
+				all, err := m.dst.ProductAttributes.List(m.ctx)
+				if err != nil {
+					return nil, err
+				}
+				m.attributes = make(map[string]int, len(all))
+				for _, a := range all {
+					m.attributes[strings.ToLower(a.Name)] = a.ID
+				}
+			}
+			attribute.ID = m.attributes[strings.ToLower(attribute.Name)]
+		}
+		mapped[i] = attribute
+	}
+	return mapped, nil
+}
+
+// shippingClass creates the shipping class slug on dst if it is missing,
+// so that the product can refer to it by slug.
+func (m *migration) shippingClass(slug string) error {
+	if m.classes == nil {
+		m.classes = map[string]int{}
+		err := listAll(m.ctx, m.dst, "products/shipping_classes", nil, func(classes []ShippingClass) error {
+			for _, class := range classes {
+				m.classes[class.Slug] = class.ID
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := m.classes[slug]; ok {
+		return nil
+	}
+	class, err := m.dst.ShippingClasses.Create(m.ctx, &ShippingClass{Name: slug, Slug: slug})
+	if err != nil {
+		return err
+	}
+	m.classes[slug] = class.ID
+	return nil
+}
+
+// terms maps refs to dst's terms by slug, creating missing terms.
+func (m *migration) terms(refs []ProductTermRef, ids map[string]int, create func(ProductTermRef) (int, error)) ([]ProductTermRef, error) {
+	mapped := make([]ProductTermRef, len(refs))
+	for i, ref := range refs {
+		id, ok := ids[ref.Slug]
+		if !ok {
+			var err error
+			if id, err = create(ref); err != nil {
+				return nil, err
+			}
+			ids[ref.Slug] = id
+		}
+		mapped[i] = ProductTermRef{ID: id}
+	}
+	return mapped, nil
+}
+
+func (m *migration) flush() error {
+	if len(m.pending) == 0 {
+		return nil
+	}
+	resp, err := m.dst.Products.Batch(m.ctx, &BatchRequest[Product]{Create: m.pending})
+	if err != nil {
+		return err
+	}
+	m.migrated += len(m.pending) - len(resp.Errors)
+	for _, err := range resp.Errors {
+		m.errs = append(m.errs, err)
+	}
+	m.pending = nil
+	return nil
+}
@@ -0,0 +1,124 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestMigrateProducts(t *testing.T) {
+	src := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/products" || r.URL.Query().Get("status") != "publish" {
+			t.Errorf("src request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`[
+			{"id":794,"name":"Hoodie","slug":"hoodie","regular_price":"45.00","categories":[{"id":15,"name":"Hoodies","slug":"hoodies"}],"tags":[{"id":3,"name":"Sale","slug":"sale"}],"images":[{"id":792,"src":"https://staging.example.com/hoodie.jpg"}],"meta_data":[{"id":11,"key":"_erp_sku","value":"H-1"}],"variations":[795,796]},
+			{"id":799,"name":"Cap","regular_price":"15.00","categories":[{"id":15,"name":"Hoodies","slug":"hoodies"}],"tags":[{"id":3,"name":"Sale","slug":"sale"}]},
+			{"id":800,"name":"Broken"}
+		]`))
+	})
+	var created []Product
+	var tagsCreated int
+	dst := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /wp-json/wc/v3/products/categories":
+			w.Write([]byte(`[{"id":9,"name":"Hoodies","slug":"hoodies"}]`))
+		case "GET /wp-json/wc/v3/products/tags":
+			w.Write([]byte(`[]`))
+		case "POST /wp-json/wc/v3/products/tags":
+			tagsCreated++
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":50,"name":"Sale","slug":"sale"}`))
+		case "POST /wp-json/wc/v3/products/batch":
+			var req BatchRequest[Product]
+			json.NewDecoder(r.Body).Decode(&req)
+			created = append(created, req.Create...)
+			w.Write([]byte(`{"create":[{"id":1001},{"id":1002},{"id":0,"error":{"code":"woocommerce_rest_product_invalid","message":"Invalid product.","data":{"status":400}}}]}`))
+		default:
+			t.Errorf("dst request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	n, err := MigrateProducts(context.Background(), src, dst, NewListParams().Status("publish"))
+	if n != 2 || err == nil {
+		t.Fatalf("migrated %d, err = %v; want 2 and the failed product's error", n, err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "woocommerce_rest_product_invalid" {
+		t.Errorf("err = %v", err)
+	}
+	if len(created) != 3 || tagsCreated != 1 {
+		t.Fatalf("created %d products and %d tags", len(created), tagsCreated)
+	}
+	hoodie := created[0]
+	if hoodie.ID != 0 || hoodie.Variations != nil || hoodie.Name != "Hoodie" || hoodie.RegularPrice.String() != "45.00" {
+		t.Errorf("hoodie = %+v", hoodie)
+	}
+	if hoodie.Categories[0].ID != 9 || hoodie.Tags[0].ID != 50 || created[1].Tags[0].ID != 50 {
+		t.Errorf("terms = %+v %+v", hoodie.Categories, hoodie.Tags)
+	}
+	if hoodie.Images[0].ID != 0 || hoodie.Images[0].Src != "https://staging.example.com/hoodie.jpg" {
+		t.Errorf("images = %+v", hoodie.Images)
+	}
+	if hoodie.MetaData[0].ID != 0 || hoodie.MetaData.String("_erp_sku") != "H-1" {
+		t.Errorf("meta = %+v", hoodie.MetaData)
+	}
+}
+
+func TestMigrateProductsAttributes(t *testing.T) {
+	src := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":794,"name":"Hoodie","price":"40.00","price_html":"<span>40.00</span>","on_sale":true,"purchasable":true,"total_sales":12,"average_rating":"4.50","rating_count":2,"date_created":"2024-01-02T03:04:05","date_modified":"2024-02-03T04:05:06",
+			"shipping_class":"bulky","shipping_class_id":7,
+			"attributes":[{"id":1,"name":"Color","options":["Blue","Red"]},{"id":2,"name":"Fabric","options":["Cotton"]},{"id":0,"name":"Fit","options":["Loose"]}],
+			"default_attributes":[{"id":1,"name":"Color","option":"Blue"}]}]`))
+	})
+	var body map[string]json.RawMessage
+	var created Product
+	var classesCreated int
+	dst := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /wp-json/wc/v3/products/categories", "GET /wp-json/wc/v3/products/tags":
+			w.Write([]byte(`[]`))
+		case "GET /wp-json/wc/v3/products/attributes":
+			w.Write([]byte(`[{"id":4,"name":"color","slug":"pa_color"}]`))
+		case "GET /wp-json/wc/v3/products/shipping_classes":
+			w.Write([]byte(`[{"id":3,"name":"Small","slug":"small"}]`))
+		case "POST /wp-json/wc/v3/products/shipping_classes":
+			classesCreated++
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":8,"name":"bulky","slug":"bulky"}`))
+		case "POST /wp-json/wc/v3/products/batch":
+			var req struct{ Create []json.RawMessage }
+			json.NewDecoder(r.Body).Decode(&req)
+			json.Unmarshal(req.Create[0], &body)
+			json.Unmarshal(req.Create[0], &created)
+			w.Write([]byte(`{"create":[{"id":1001}]}`))
+		default:
+			t.Errorf("dst request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	if n, err := MigrateProducts(context.Background(), src, dst, nil); n != 1 || err != nil {
+		t.Fatalf("migrated %d, err = %v", n, err)
+	}
+	for _, field := range []string{"price", "price_html", "on_sale", "purchasable", "total_sales", "average_rating", "rating_count", "date_created", "date_modified", "shipping_class_id"} {
+		if _, ok := body[field]; ok {
+			t.Errorf("sent read-only field %s", field)
+		}
+	}
+	if created.ShippingClass != "bulky" || classesCreated != 1 {
+		t.Errorf("shipping class = %q, created %d", created.ShippingClass, classesCreated)
+	}
+	var ids []int
+	for _, attribute := range created.Attributes {
+		ids = append(ids, attribute.ID)
+	}
+	if len(ids) != 3 || ids[0] != 4 || ids[1] != 0 || ids[2] != 0 || created.Attributes[1].Name != "Fabric" {
+		t.Errorf("attributes = %+v", created.Attributes)
+	}
+	if len(created.DefaultAttributes) != 1 || created.DefaultAttributes[0].ID != 4 || created.DefaultAttributes[0].Option != "Blue" {
+		t.Errorf("default attributes = %+v", created.DefaultAttributes)
+	}
+}
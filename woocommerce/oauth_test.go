@@ -0,0 +1,87 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPercentEncode(t *testing.T) {
+	for in, want := range map[string]string{
+		"abcABC123-._~": "abcABC123-._~",
+		"a b+c":         "a%20b%2Bc",
+		"a=b&c":         "a%3Db%26c",
+		"é":             "%C3%A9",
+		"[]*":           "%5B%5D%2A",
+	} {
+		if got := percentEncode(in); got != want {
+			t.Errorf("percentEncode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestOAuthBaseString(t *testing.T) {
+	params := url.Values{
+		"search":          {"red shirt"},
+		"a":               {"2", "1"},
+		"oauth_signature": {"ignored"},
+	}
+	got := oauthBaseString("get", "http://example.com/wp-json/wc/v3/products", params)
+	want := "GET&http%3A%2F%2Fexample.com%2Fwp-json%2Fwc%2Fv3%2Fproducts&a%3D1%26a%3D2%26search%3Dred%2520shirt"
+	if got != want {
+		t.Errorf("base string = %s, want %s", got, want)
+	}
+}
+
+func TestOAuthSignature(t *testing.T) {
+	var queries []url.Values
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		queries = append(queries, query)
+		base := oauthBaseString(r.Method, "http://"+r.Host+r.URL.Path, query)
+		if !VerifyHMAC("cs&", []byte(base), query.Get("oauth_signature")) {
+			t.Errorf("signature of %s does not verify", base)
+		}
+		w.Write([]byte("[]"))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		body, err := client.Get(context.Background(), "products", url.Values{"search": {"red shirt~"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		body.Close()
+	}
+	if queries[0].Get("oauth_nonce") == queries[1].Get("oauth_nonce") {
+		t.Error("nonce reused across requests")
+	}
+	ts, _ := strconv.ParseInt(queries[1].Get("oauth_timestamp"), 10, 64)
+	if d := time.Now().Unix() - ts; d < 0 || d > 5 {
+		t.Errorf("timestamp is %ds old", d)
+	}
+}
+
+func TestOAuthSigningKey(t *testing.T) {
+	for _, tc := range []struct {
+		option *Option
+		want   string
+	}{
+		{&Option{}, "cs&"},
+		{&Option{LegacyAPI: true}, "cs&"},
+		{&Option{LegacyAPI: true, Version: "v2"}, "cs"},
+	} {
+		client, err := NewClient("http://example.com", "ck", "cs", tc.option)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := client.oauthSigningKey(); got != tc.want {
+			t.Errorf("%+v: key = %q, want %q", tc.option, got, tc.want)
+		}
+	}
+}
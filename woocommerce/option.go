@@ -1,15 +1,109 @@
 package woocommerce
 
 import (
+	"log/slog"
+	"net/http"
 	"time"
 )
 
 type Option struct {
-	API             bool
-	APIPrefix       string
-	Version         string
+	// API is ignored. The WP REST API is used unless LegacyAPI is set.
+	//
+	// Deprecated: the WP REST API is the default.
+	API bool
+	// APIPrefix is the WP REST API prefix, "/wp-json/" by default. Stores
+	// can change it with the rest_url_prefix filter.
+	APIPrefix string
+	// Version is the API version, "wc/v3" by default. "v1" to "v3" are
+	// short for "wc/v1" to "wc/v3" unless LegacyAPI is set.
+	Version string
+	// LegacyAPI targets the legacy /wc-api/ API, removed in WooCommerce
+	// 9.0, instead of the WP REST API.
+	LegacyAPI bool
+	// Timeout limits each call, including retries and reading the
+	// response body. WithTimeout overrides it for a single call. Zero
+	// means no limit beyond the context's own deadline.
 	Timeout         time.Duration
 	VerifySSL       bool
 	QueryStringAuth string
-	OauthTimestamp  time.Time
+	// OauthTimestamp fixes the oauth_timestamp of every signed request,
+	// e.g. to match recorded fixtures. Zero uses the time of each request.
+	OauthTimestamp time.Time
+	ForceAuthMode  AuthMode
+	// Auth, if set, authenticates requests instead of the consumer key and
+	// secret, which may then be empty; see JWTAuth.
+	Auth Authenticator
+	// Language requests translated responses. It is sent both as the
+	// Accept-Language header and as the lang query parameter, which is
+	// what WPML and Polylang read on REST requests.
+	Language string
+	// Recorder, if set, sees every request and response; see FileRecorder
+	// and Replayer for VCR-style fixtures.
+	Recorder Recorder
+	// Retry enables automatic retries; nil sends every request once. The
+	// number of attempts, the backoff and which statuses are retried, e.g.
+	// 409 conflicts, are set on the RetryPolicy.
+	Retry *RetryPolicy
+	// MaxMaintenanceWait lets requests wait out maintenance mode: a 503
+	// response whose Retry-After is longer than RetryPolicy.MaxRetryAfter
+	// pauses the request, logged at warn level, for up to this long in
+	// total before it is sent again. Pauses do not count as retry
+	// attempts. Zero fails such requests.
+	MaxMaintenanceWait time.Duration
+	// RateLimit paces outgoing requests to this many per second, allowing
+	// bursts of up to RateBurst. Zero disables rate limiting.
+	RateLimit float64
+	RateBurst int
+	// UserAgent replaces the default User-Agent header, UserAgent.
+	UserAgent string
+	// Header is sent with every request, e.g. a token required by a
+	// security plugin. WithHeader adds headers to a single call.
+	Header http.Header
+	// CompressRequests gzips request bodies of 1KB or more. The web
+	// server must be set up to decode them, which is not the default for
+	// Apache or nginx. Responses are always accepted gzipped.
+	CompressRequests bool
+	// ProxyURL routes requests through a proxy, e.g.
+	// "http://proxy.example.com:3128". When empty the HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables are used.
+	ProxyURL string
+	// HTTPClient replaces the client used to send requests, for custom
+	// transports, proxies or instrumentation. VerifySSL and ProxyURL are
+	// ignored when it is set; authentication and signing are still applied.
+	HTTPClient *http.Client
+	// LogHandler receives a record for every request attempt and retry.
+	// Successful attempts are logged at debug level, failures at warn.
+	// Credentials are redacted. Nothing is logged when it is nil.
+	LogHandler slog.Handler
+	// Metrics, if set, records request durations, statuses and retries.
+	Metrics Metrics
+	// DataCacheTTL caches the countries, currencies and continents read
+	// through Client.Data for this long. Zero disables the cache.
+	DataCacheTTL time.Duration
+	// Cache, if set, stores GET responses with an ETag or Last-Modified
+	// header and revalidates them with conditional requests, returning
+	// the cached body when the store answers 304 Not Modified.
+	Cache ResponseCache
+	// IdempotencyLedger, if set, suppresses duplicate POST requests made
+	// with the same WithIdempotencyKey key.
+	IdempotencyLedger IdempotencyLedger
+	// DryRun, if set, captures requests that would change the store
+	// instead of sending them; see DryRun.
+	DryRun *DryRun
+	// CircuitBreaker, if set, fails requests fast while the store keeps
+	// failing; see CircuitBreaker.
+	CircuitBreaker *CircuitBreaker
 }
+
+// AuthMode selects how requests are authenticated. The zero value picks
+// OAuth for http stores and query string keys for https stores.
+type AuthMode int
+
+const (
+	AuthModeAuto AuthMode = iota
+	AuthModeOAuth
+	AuthModeQueryString
+	// AuthModeBasicHeader sends the keys in an HTTP Basic Authorization
+	// header, keeping them out of access logs. Use it over https only.
+	AuthModeBasicHeader
+)
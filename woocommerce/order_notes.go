@@ -0,0 +1,53 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// OrderNotesService manages /orders/{id}/notes. Notes with CustomerNote set
+// are shown to the customer and emailed to them; other notes are private.
+type OrderNotesService service
+
+type OrderNote struct {
+	ID             int    `json:"id,omitempty"`
+	Author         string `json:"author,omitempty"`
+	DateCreated    WCTime `json:"date_created,omitzero"`
+	DateCreatedGMT WCTime `json:"date_created_gmt,omitzero"`
+	Note           string `json:"note,omitempty"`
+	CustomerNote   *bool  `json:"customer_note,omitempty"`
+	AddedByUser    bool   `json:"added_by_user,omitempty"`
+}
+
+// Order note types, for filtering with ListParams.Set("type", ...).
+const (
+	OrderNoteTypeAny      = "any"
+	OrderNoteTypeCustomer = "customer"
+	OrderNoteTypeInternal = "internal"
+)
+
+func (s *OrderNotesService) List(ctx context.Context, orderID int, params *ListParams) ([]OrderNote, *Response, error) {
+	var notes []OrderNote
+	resp, err := s.client.list(ctx, buildPath("orders", orderID, "notes"), params, &notes)
+	return notes, resp, err
+}
+
+func (s *OrderNotesService) Get(ctx context.Context, orderID, noteID int) (*OrderNote, error) {
+	note := new(OrderNote)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("orders", orderID, "notes", noteID), nil, nil, note)
+	return note, err
+}
+
+func (s *OrderNotesService) Create(ctx context.Context, orderID int, note *OrderNote) (*OrderNote, error) {
+	created := new(OrderNote)
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("orders", orderID, "notes"), nil, note, created)
+	return created, err
+}
+
+// Delete deletes a note. Notes cannot be trashed, so the request is always
+// forced.
+func (s *OrderNotesService) Delete(ctx context.Context, orderID, noteID int) (*OrderNote, error) {
+	deleted := new(OrderNote)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("orders", orderID, "notes", noteID), forceParam(true), nil, deleted)
+	return deleted, err
+}
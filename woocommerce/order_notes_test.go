@@ -0,0 +1,65 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const orderNoteJSON = `{"id":281,"author":"system","date_created":"2017-03-21T16:46:41","note":"Tracking number: 1Z999AA10123456784","customer_note":true}`
+
+func TestOrderNotesCreate(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/wp-json/wc/v3/orders/723/notes" {
+			t.Errorf("create sent %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(orderNoteJSON))
+	})
+	note, err := client.OrderNotes.Create(context.Background(), 723, &OrderNote{
+		Note:         "Tracking number: 1Z999AA10123456784",
+		CustomerNote: Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if note.ID != 281 || !isTrue(note.CustomerNote) || note.Author != "system" {
+		t.Errorf("note = %+v", note)
+	}
+	if body["customer_note"] != true || body["note"] != "Tracking number: 1Z999AA10123456784" {
+		t.Errorf("body = %v", body)
+	}
+}
+
+func TestOrderNotesList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/orders/723/notes" || r.URL.Query().Get("type") != "customer" {
+			t.Errorf("list sent %s", r.URL)
+		}
+		w.Write([]byte("[" + orderNoteJSON + "]"))
+	})
+	notes, _, err := client.OrderNotes.List(context.Background(), 723, NewListParams().Set("type", OrderNoteTypeCustomer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes) != 1 || notes[0].ID != 281 {
+		t.Errorf("notes = %+v", notes)
+	}
+}
+
+func TestOrderNotesDelete(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/wp-json/wc/v3/orders/723/notes/281" || r.URL.Query().Get("force") != "true" {
+			t.Errorf("delete sent %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(orderNoteJSON))
+	})
+	if _, err := client.OrderNotes.Delete(context.Background(), 723, 281); err != nil {
+		t.Fatal(err)
+	}
+}
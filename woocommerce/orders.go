@@ -0,0 +1,368 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// OrdersService manages /orders. Fields left at their zero value are
+// omitted from create and update requests.
+type OrdersService service
+
+// OrderStatus is the status of an order. Plugins can register further
+// statuses, and Subscription uses it for the subscription statuses.
+type OrderStatus string
+
+const (
+	OrderStatusPending    OrderStatus = "pending"
+	OrderStatusProcessing OrderStatus = "processing"
+	OrderStatusOnHold     OrderStatus = "on-hold"
+	OrderStatusCompleted  OrderStatus = "completed"
+	OrderStatusCancelled  OrderStatus = "cancelled"
+	OrderStatusRefunded   OrderStatus = "refunded"
+	OrderStatusFailed     OrderStatus = "failed"
+	OrderStatusTrash      OrderStatus = "trash"
+	OrderStatusDraft      OrderStatus = "checkout-draft"
+)
+
+type Order struct {
+	ID                 int            `json:"id,omitempty"`
+	ParentID           int            `json:"parent_id,omitempty"`
+	Number             string         `json:"number,omitempty"`
+	OrderKey           string         `json:"order_key,omitempty"`
+	CreatedVia         string         `json:"created_via,omitempty"`
+	Version            string         `json:"version,omitempty"`
+	Status             OrderStatus    `json:"status,omitempty"`
+	Currency           string         `json:"currency,omitempty"`
+	DateCreated        WCTime         `json:"date_created,omitzero"`
+	DateCreatedGMT     WCTime         `json:"date_created_gmt,omitzero"`
+	DateModified       WCTime         `json:"date_modified,omitzero"`
+	DateModifiedGMT    WCTime         `json:"date_modified_gmt,omitzero"`
+	DiscountTotal      Decimal        `json:"discount_total,omitzero"`
+	DiscountTax        Decimal        `json:"discount_tax,omitzero"`
+	ShippingTotal      Decimal        `json:"shipping_total,omitzero"`
+	ShippingTax        Decimal        `json:"shipping_tax,omitzero"`
+	CartTax            Decimal        `json:"cart_tax,omitzero"`
+	Total              Decimal        `json:"total,omitzero"`
+	TotalTax           Decimal        `json:"total_tax,omitzero"`
+	PricesIncludeTax   bool           `json:"prices_include_tax,omitempty"`
+	CustomerID         int            `json:"customer_id,omitempty"`
+	CustomerIPAddress  string         `json:"customer_ip_address,omitempty"`
+	CustomerUserAgent  string         `json:"customer_user_agent,omitempty"`
+	CustomerNote       string         `json:"customer_note,omitempty"`
+	Billing            *Address       `json:"billing,omitempty"`
+	Shipping           *Address       `json:"shipping,omitempty"`
+	PaymentMethod      string         `json:"payment_method,omitempty"`
+	PaymentMethodTitle string         `json:"payment_method_title,omitempty"`
+	TransactionID      string         `json:"transaction_id,omitempty"`
+	DatePaid           WCTime         `json:"date_paid,omitzero"`
+	DatePaidGMT        WCTime         `json:"date_paid_gmt,omitzero"`
+	DateCompleted      WCTime         `json:"date_completed,omitzero"`
+	DateCompletedGMT   WCTime         `json:"date_completed_gmt,omitzero"`
+	CartHash           string         `json:"cart_hash,omitempty"`
+	SetPaid            *bool          `json:"set_paid,omitempty"`
+	MetaData           Meta           `json:"meta_data,omitempty"`
+	LineItems          []LineItem     `json:"line_items,omitempty"`
+	TaxLines           []TaxLine      `json:"tax_lines,omitempty"`
+	ShippingLines      []ShippingLine `json:"shipping_lines,omitempty"`
+	FeeLines           []FeeLine      `json:"fee_lines,omitempty"`
+	CouponLines        []CouponLine   `json:"coupon_lines,omitempty"`
+	// Refunds summarises the order's refunds. It is read-only.
+	Refunds []OrderRefund `json:"refunds,omitempty"`
+}
+
+// OrderRefund is the summary of a refund listed on its order. Total is
+// negative, as WooCommerce reports it.
+type OrderRefund struct {
+	ID     int     `json:"id"`
+	Reason string  `json:"reason,omitempty"`
+	Total  Decimal `json:"total,omitzero"`
+}
+
+// Address is a billing or shipping address. Email and Phone are only used
+// for billing addresses.
+type Address struct {
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Address1  string `json:"address_1,omitempty"`
+	Address2  string `json:"address_2,omitempty"`
+	City      string `json:"city,omitempty"`
+	State     string `json:"state,omitempty"`
+	Postcode  string `json:"postcode,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+}
+
+type LineItem struct {
+	ID          int       `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	ProductID   int       `json:"product_id,omitempty"`
+	VariationID int       `json:"variation_id,omitempty"`
+	Quantity    int       `json:"quantity,omitempty"`
+	TaxClass    string    `json:"tax_class,omitempty"`
+	Subtotal    Decimal   `json:"subtotal,omitzero"`
+	SubtotalTax Decimal   `json:"subtotal_tax,omitzero"`
+	Total       Decimal   `json:"total,omitzero"`
+	TotalTax    Decimal   `json:"total_tax,omitzero"`
+	Taxes       []LineTax `json:"taxes,omitempty"`
+	MetaData    Meta      `json:"meta_data,omitempty"`
+	SKU         string    `json:"sku,omitempty"`
+	Price       Decimal   `json:"price,omitzero"`
+	// CategoryIDs are the categories of the line's product. They are not
+	// part of the API; set them for Coupon.ApplicableTo to check category
+	// restrictions.
+	CategoryIDs []int `json:"-"`
+}
+
+// DiscountAmount returns the coupon discount applied to the line, the
+// subtotal before discounts minus the total after them, both excluding
+// tax.
+func (l LineItem) DiscountAmount() Decimal {
+	return l.Subtotal.Sub(l.Total)
+}
+
+// LineTax is the tax applied to a single line of an order.
+type LineTax struct {
+	ID       int     `json:"id"`
+	Total    Decimal `json:"total,omitzero"`
+	Subtotal Decimal `json:"subtotal,omitzero"`
+}
+
+type TaxLine struct {
+	ID               int     `json:"id,omitempty"`
+	RateCode         string  `json:"rate_code,omitempty"`
+	RateID           int     `json:"rate_id,omitempty"`
+	Label            string  `json:"label,omitempty"`
+	Compound         bool    `json:"compound,omitempty"`
+	TaxTotal         Decimal `json:"tax_total,omitzero"`
+	ShippingTaxTotal Decimal `json:"shipping_tax_total,omitzero"`
+	MetaData         Meta    `json:"meta_data,omitempty"`
+}
+
+type ShippingLine struct {
+	ID          int       `json:"id,omitempty"`
+	MethodTitle string    `json:"method_title,omitempty"`
+	MethodID    string    `json:"method_id,omitempty"`
+	Total       Decimal   `json:"total,omitzero"`
+	TotalTax    Decimal   `json:"total_tax,omitzero"`
+	Taxes       []LineTax `json:"taxes,omitempty"`
+	MetaData    Meta      `json:"meta_data,omitempty"`
+}
+
+type FeeLine struct {
+	ID        int       `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	TaxClass  string    `json:"tax_class,omitempty"`
+	TaxStatus string    `json:"tax_status,omitempty"`
+	Total     Decimal   `json:"total,omitzero"`
+	TotalTax  Decimal   `json:"total_tax,omitzero"`
+	Taxes     []LineTax `json:"taxes,omitempty"`
+	MetaData  Meta      `json:"meta_data,omitempty"`
+}
+
+type CouponLine struct {
+	ID          int     `json:"id,omitempty"`
+	Code        string  `json:"code,omitempty"`
+	Discount    Decimal `json:"discount,omitzero"`
+	DiscountTax Decimal `json:"discount_tax,omitzero"`
+	MetaData    Meta    `json:"meta_data,omitempty"`
+}
+
+// Money returns amount, one of the order's totals, in the order currency,
+// e.g. order.Money(order.Total).
+func (o *Order) Money(amount Decimal) Money {
+	return Money{Amount: amount, Currency: o.Currency}
+}
+
+// TotalRefunded returns the amount refunded so far, as a positive value,
+// from the refunds listed on the order.
+func (o *Order) TotalRefunded() Decimal {
+	var total Decimal
+	for _, refund := range o.Refunds {
+		total = total.Sub(refund.Total)
+	}
+	return total
+}
+
+func (s *OrdersService) List(ctx context.Context, params *ListParams) ([]Order, *Response, error) {
+	var orders []Order
+	resp, err := s.client.list(ctx, "orders", params, &orders)
+	return orders, resp, err
+}
+
+// ListByStatus lists orders in any of the given statuses, e.g.
+// OrderStatusProcessing or OrderStatusOnHold. Other filters can be passed
+// in params.
+func (s *OrdersService) ListByStatus(ctx context.Context, params *ListParams, statuses ...OrderStatus) ([]Order, *Response, error) {
+	if params == nil {
+		params = NewListParams()
+	}
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return s.List(ctx, params.Status(values...))
+}
+
+// ListAll calls fn with every page of orders matching params.
+func (s *OrdersService) ListAll(ctx context.Context, params *ListParams, fn func([]Order) error) error {
+	return listAll(ctx, s.client, "orders", params, fn)
+}
+
+// Stream calls fn with every order matching params, decoding them one at a
+// time as they arrive; see Client.Stream.
+func (s *OrdersService) Stream(ctx context.Context, params *ListParams, fn func(*Order) error) error {
+	return streamList(ctx, s.client, "orders", params, fn)
+}
+
+// BulkFetch calls fn with every page of orders matching params, fetching
+// pages concurrently; see Client.BulkFetch.
+func (s *OrdersService) BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Order) error) error {
+	return bulkFetch(ctx, s.client, "orders", params, opts, fn)
+}
+
+func (s *OrdersService) Get(ctx context.Context, id int) (*Order, error) {
+	order := new(Order)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("orders", id), nil, nil, order)
+	return order, err
+}
+
+func (s *OrdersService) Create(ctx context.Context, order *Order) (*Order, error) {
+	created := new(Order)
+	_, err := s.client.call(ctx, http.MethodPost, "orders", nil, order, created)
+	return created, err
+}
+
+func (s *OrdersService) Update(ctx context.Context, id int, order *Order) (*Order, error) {
+	updated := new(Order)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("orders", id), nil, order, updated)
+	return updated, err
+}
+
+// Delete moves an order to the trash, or deletes it permanently when force
+// is true. It returns the deleted order.
+func (s *OrdersService) Delete(ctx context.Context, id int, force bool) (*Order, error) {
+	deleted := new(Order)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("orders", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *OrdersService) Batch(ctx context.Context, req *BatchRequest[Order]) (*BatchResponse[Order], error) {
+	return batch(ctx, s.client, "orders/batch", req)
+}
+
+// CountByStatus returns the number of orders in each status, including
+// statuses registered by plugins, from the order totals report in a single
+// request.
+func (s *OrdersService) CountByStatus(ctx context.Context) (map[OrderStatus]int, error) {
+	totals, err := s.client.Reports.Totals(ctx, "orders")
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[OrderStatus]int, len(totals))
+	for _, total := range totals {
+		counts[OrderStatus(total.Slug)] = total.Total
+	}
+	return counts, nil
+}
+
+// ExternalIDMetaKey is the meta key under which CreateByExternalID stores
+// the external order id.
+const ExternalIDMetaKey = "_external_order_id"
+
+// ErrExternalIDNotSearchable is returned by CreateByExternalID when an
+// order it created cannot be found by its external id, so a retry would
+// create a duplicate.
+var ErrExternalIDNotSearchable = errors.New("Orders cannot be searched by external id")
+
+// CreateByExternalID creates order unless an order carrying externalID
+// under ExternalIDMetaKey already exists, in which case that order is
+// returned instead, so that re-sending an order after a crash does not
+// duplicate it. order itself is not modified.
+//
+// Existing orders are found with the search parameter and then matched on
+// the meta value. WooCommerce does not search order meta by default, so
+// the store must add ExternalIDMetaKey to order search, e.g. with the
+// woocommerce_shop_order_search_fields filter. Each created order is
+// looked up again to check this; if it is not found the order is returned
+// with ErrExternalIDNotSearchable.
+//
+// The create is also sent with the idempotency key "order:" + externalID,
+// so a client with Option.IdempotencyLedger does not repeat it.
+func (s *OrdersService) CreateByExternalID(ctx context.Context, externalID string, order *Order) (*Order, error) {
+	existing, err := s.findByExternalID(ctx, externalID)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+	create := *order
+	create.MetaData = append(Meta(nil), order.MetaData...)
+	create.MetaData.Set(ExternalIDMetaKey, externalID)
+	created, err := s.Create(WithIdempotencyKey(ctx, "order:"+externalID), &create)
+	if err != nil {
+		return nil, err
+	}
+	found, err := s.findByExternalID(ctx, externalID)
+	if err != nil {
+		return created, err
+	}
+	if found == nil || found.ID != created.ID {
+		return created, ErrExternalIDNotSearchable
+	}
+	return created, nil
+}
+
+func (s *OrdersService) findByExternalID(ctx context.Context, externalID string) (*Order, error) {
+	var existing *Order
+	err := s.ListAll(ctx, NewListParams().Search(externalID), func(orders []Order) error {
+		for i := range orders {
+			if existing == nil && orders[i].MetaData.String(ExternalIDMetaKey) == externalID {
+				existing = &orders[i]
+			}
+		}
+		return nil
+	})
+	return existing, err
+}
+
+// Poll calls fn with every order created or modified since cursor, then
+// advances cursor past it, and repeats every interval until ctx is done or
+// fn returns an error, for stores where webhooks cannot be used. Requests
+// wait for the client's rate limiter.
+//
+// Delivery is at least once: an order is passed to fn again if it is
+// modified again, or if fn failed on it. Store cursor once fn has
+// returned, e.g. as JSON, to resume after a restart. Poll returns the
+// context's error or fn's error; errors from requests are returned too,
+// leaving cursor at the last order delivered.
+func (s *OrdersService) Poll(ctx context.Context, interval time.Duration, cursor *SyncCursor, fn func(Order) error) error {
+	for {
+		err := walkModified(ctx, s.client, "orders", nil, func() time.Time { return cursor.Modified }, func(items []json.RawMessage) error {
+			for _, raw := range items {
+				var order Order
+				if err := json.Unmarshal(raw, &order); err != nil {
+					return err
+				}
+				modified := order.DateModifiedGMT.Time
+				if cursor.seen(order.ID, modified) {
+					continue
+				}
+				if err := fn(order); err != nil {
+					return err
+				}
+				cursor.advance(order.ID, modified)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
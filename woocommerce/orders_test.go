@@ -0,0 +1,330 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const orderJSON = `{
+	"id": 727,
+	"parent_id": 0,
+	"number": "727",
+	"status": "processing",
+	"currency": "USD",
+	"total": "29.35",
+	"total_tax": "1.35",
+	"customer_id": 0,
+	"billing": {"first_name": "John", "last_name": "Doe", "address_1": "969 Market", "city": "San Francisco", "state": "CA", "postcode": "94103", "country": "US", "email": "john.doe@example.com", "phone": "(555) 555-5555"},
+	"shipping": {"first_name": "John", "last_name": "Doe", "address_1": "969 Market", "city": "San Francisco", "state": "CA", "postcode": "94103", "country": "US"},
+	"payment_method": "bacs",
+	"line_items": [{"id": 315, "name": "Woo Single #1", "product_id": 93, "variation_id": 0, "quantity": 2, "subtotal": "6.00", "subtotal_tax": "0.45", "total": "6.00", "total_tax": "0.45", "taxes": [{"id": 75, "total": "0.45", "subtotal": "0.45"}], "sku": "", "price": 3}],
+	"tax_lines": [{"id": 318, "rate_code": "US-CA-STATE TAX", "rate_id": 75, "label": "State Tax", "compound": false, "tax_total": "1.35", "shipping_tax_total": "0.00"}],
+	"shipping_lines": [{"id": 317, "method_title": "Flat Rate", "method_id": "flat_rate", "total": "10.00", "total_tax": "0.00"}],
+	"fee_lines": [{"id": 319, "name": "Gift wrap", "total": "2.00"}],
+	"coupon_lines": [{"id": 320, "code": "10off", "discount": "1.00"}]
+}`
+
+func TestOrdersGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/orders/727" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(orderJSON))
+	})
+	order, err := client.Orders.Get(context.Background(), 727)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 727 || order.Status != "processing" || order.Total.String() != "29.35" {
+		t.Errorf("unexpected order: %+v", order)
+	}
+	if order.Billing == nil || order.Billing.Email != "john.doe@example.com" || order.Shipping.City != "San Francisco" {
+		t.Errorf("addresses = %+v / %+v", order.Billing, order.Shipping)
+	}
+	if len(order.LineItems) != 1 || order.LineItems[0].Quantity != 2 || order.LineItems[0].Taxes[0].ID != 75 {
+		t.Errorf("LineItems = %+v", order.LineItems)
+	}
+	if len(order.TaxLines) != 1 || len(order.ShippingLines) != 1 || len(order.FeeLines) != 1 || len(order.CouponLines) != 1 {
+		t.Errorf("lines = %d tax, %d shipping, %d fee, %d coupon", len(order.TaxLines), len(order.ShippingLines), len(order.FeeLines), len(order.CouponLines))
+	}
+	if order.ShippingLines[0].MethodID != "flat_rate" || order.CouponLines[0].Code != "10off" {
+		t.Errorf("unexpected lines: %+v %+v", order.ShippingLines, order.CouponLines)
+	}
+}
+
+func TestOrdersListByStatus(t *testing.T) {
+	var status, perPage string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		status, perPage = r.URL.Query().Get("status"), r.URL.Query().Get("per_page")
+		w.Write([]byte("[" + orderJSON + "]"))
+	})
+	orders, _, err := client.Orders.ListByStatus(context.Background(), NewListParams().PerPage(20), OrderStatusProcessing, OrderStatusOnHold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].ID != 727 {
+		t.Errorf("orders = %+v", orders)
+	}
+	if status != "processing,on-hold" || perPage != "20" {
+		t.Errorf("status = %q, per_page = %q", status, perPage)
+	}
+	if _, _, err := client.Orders.ListByStatus(context.Background(), nil, OrderStatusCompleted); err != nil {
+		t.Fatal(err)
+	}
+	if status != "completed" {
+		t.Errorf("status = %q, want completed", status)
+	}
+}
+
+func TestOrdersCreate(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s", r.Method)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(orderJSON))
+	})
+	order, err := client.Orders.Create(context.Background(), &Order{
+		PaymentMethod: "bacs",
+		SetPaid:       Bool(true),
+		Billing:       &Address{FirstName: "John", Email: "john.doe@example.com"},
+		LineItems:     []LineItem{{ProductID: 93, Quantity: 2}},
+		ShippingLines: []ShippingLine{{MethodID: "flat_rate", MethodTitle: "Flat Rate", Total: MustParseDecimal("10.00")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 727 {
+		t.Errorf("ID = %d", order.ID)
+	}
+	items, _ := body["line_items"].([]interface{})
+	if body["set_paid"] != true || len(items) != 1 || items[0].(map[string]interface{})["product_id"] != 93.0 {
+		t.Errorf("body = %v", body)
+	}
+}
+
+func TestOrdersUpdateDelete(t *testing.T) {
+	var method, path, force string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, force = r.Method, r.URL.Path, r.URL.Query().Get("force")
+		w.Write([]byte(orderJSON))
+	})
+	if _, err := client.Orders.Update(context.Background(), 727, &Order{Status: "completed"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/orders/727" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+	if _, err := client.Orders.Delete(context.Background(), 727, false); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || force != "" {
+		t.Errorf("delete sent %s force=%q", method, force)
+	}
+}
+
+func TestOrdersCreateByExternalID(t *testing.T) {
+	var orders []Order
+	creates := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			creates++
+			var order Order
+			json.NewDecoder(r.Body).Decode(&order)
+			order.ID = 700 + creates
+			orders = append(orders, order)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(order)
+			return
+		}
+		// A loose match on another order must not be taken for the original.
+		found := []Order{{ID: 1, MetaData: Meta{{Key: ExternalIDMetaKey, Value: "POS-1001-B"}}}}
+		for _, o := range orders {
+			if strings.Contains(o.MetaData.String(ExternalIDMetaKey), r.URL.Query().Get("search")) {
+				found = append(found, o)
+			}
+		}
+		json.NewEncoder(w).Encode(found)
+	})
+	ctx := context.Background()
+	order := &Order{PaymentMethod: "cash", MetaData: Meta{{Key: "_register", Value: "3"}}}
+
+	first, err := client.Orders.CreateByExternalID(ctx, "POS-1001", order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != 701 || first.MetaData.String(ExternalIDMetaKey) != "POS-1001" || first.MetaData.String("_register") != "3" {
+		t.Errorf("created %+v", first)
+	}
+	if len(order.MetaData) != 1 {
+		t.Errorf("order modified: %+v", order.MetaData)
+	}
+
+	again, err := client.Orders.CreateByExternalID(ctx, "POS-1001", order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != 701 || creates != 1 {
+		t.Errorf("re-create returned %d after %d creates", again.ID, creates)
+	}
+}
+
+func TestOrdersCreateByExternalIDNotSearchable(t *testing.T) {
+	var creates atomic.Int32
+	client, err := NewClient(newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			// Stock search ignores meta, so the order is never found.
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id":%d,"meta_data":[{"key":%q,"value":"POS-1001"}]}`, 700+creates.Add(1), ExternalIDMetaKey)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}).URL, "ck", "cs", &Option{IdempotencyLedger: NewMemoryLedger()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		created, err := client.Orders.CreateByExternalID(ctx, "POS-1001", &Order{})
+		if !errors.Is(err, ErrExternalIDNotSearchable) || created == nil || created.ID != 701 {
+			t.Errorf("attempt %d: created %+v, err = %v", i, created, err)
+		}
+	}
+	// The ledger keeps the retry from creating a duplicate.
+	if n := creates.Load(); n != 1 {
+		t.Errorf("creates = %d, want 1", n)
+	}
+}
+
+func TestOrdersPoll(t *testing.T) {
+	base := time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC)
+	at := func(s int) string { return base.Add(time.Duration(s) * time.Second).Format(wcTimeLayout) }
+	var mu sync.Mutex
+	orders := []*watchedOrder{
+		{ID: 1, Status: "completed", DateModifiedGMT: at(0)},
+		{ID: 2, Status: "processing", DateModifiedGMT: at(5)},
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		if q.Get("orderby") != "modified" || q.Get("order") != "asc" || q.Get("dates_are_gmt") != "true" {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		after, _ := time.Parse(time.RFC3339, q.Get("modified_after"))
+		list := []*watchedOrder{}
+		for _, o := range orders {
+			if o.DateModifiedGMT > after.Format(wcTimeLayout) {
+				list = append(list, o)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].DateModifiedGMT < list[j].DateModifiedGMT })
+		json.NewEncoder(w).Encode(list)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cursor := &SyncCursor{}
+	var got []int
+	polls := 0
+	err := client.Orders.Poll(ctx, time.Millisecond, cursor, func(order Order) error {
+		got = append(got, order.ID)
+		if order.ID == 2 && polls == 0 {
+			polls++
+			// Order 3 lands in the cursor's second, order 1 changes later.
+			mu.Lock()
+			orders = append(orders, &watchedOrder{ID: 3, Status: "pending", DateModifiedGMT: at(5)})
+			orders[0].DateModifiedGMT = at(8)
+			mu.Unlock()
+		}
+		if order.ID == 1 && len(got) > 1 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("err = %v", err)
+	}
+	if len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 1 {
+		t.Errorf("delivered %v, want [1 2 3 1]", got)
+	}
+	if !cursor.Modified.Equal(base.Add(8*time.Second)) || len(cursor.IDs) != 1 || cursor.IDs[0] != 1 {
+		t.Errorf("cursor = %+v", cursor)
+	}
+}
+
+func TestOrderTotalRefunded(t *testing.T) {
+	var order Order
+	data := `{"id":727,"total":"29.35","refunds":[{"id":730,"reason":"Damaged","total":"-10.00"},{"id":731,"reason":"","total":"-2.50"}]}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatal(err)
+	}
+	if len(order.Refunds) != 2 || order.Refunds[0].ID != 730 || order.Refunds[0].Reason != "Damaged" {
+		t.Errorf("refunds = %+v", order.Refunds)
+	}
+	if got := order.TotalRefunded(); got.String() != "12.50" {
+		t.Errorf("TotalRefunded() = %s, want 12.50", got)
+	}
+	if got := (&Order{}).TotalRefunded(); got.Sign() != 0 {
+		t.Errorf("TotalRefunded() without refunds = %s", got)
+	}
+}
+
+func TestOrdersCountByStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/reports/orders/totals" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"slug":"pending","name":"Pending payment","total":7},{"slug":"processing","name":"Processing","total":3},{"slug":"on-hold","name":"On hold","total":0},{"slug":"shipped","name":"Shipped","total":12}]`))
+	})
+	counts, err := client.Orders.CountByStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 4 || counts[OrderStatusPending] != 7 || counts[OrderStatusProcessing] != 3 || counts[OrderStatus("shipped")] != 12 {
+		t.Errorf("counts = %v", counts)
+	}
+	if n, ok := counts[OrderStatusOnHold]; !ok || n != 0 {
+		t.Errorf("on-hold = %d, %v", n, ok)
+	}
+}
+
+func TestLineItemDiscountAmount(t *testing.T) {
+	var order Order
+	data := `{"id":727,"line_items":[
+		{"id":315,"quantity":2,"subtotal":"42.00","subtotal_tax":"4.20","total":"37.80","total_tax":"3.78"},
+		{"id":316,"quantity":1,"subtotal":"18.00","total":"18.00"}
+	]}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatal(err)
+	}
+	item := order.LineItems[0]
+	if item.Subtotal.String() != "42.00" || item.SubtotalTax.String() != "4.20" || item.Total.String() != "37.80" || item.TotalTax.String() != "3.78" {
+		t.Errorf("line item = %+v", item)
+	}
+	if got := item.DiscountAmount(); got.String() != "4.20" {
+		t.Errorf("DiscountAmount() = %s, want 4.20", got)
+	}
+	if got := order.LineItems[1].DiscountAmount(); got.Sign() != 0 {
+		t.Errorf("DiscountAmount() without coupon = %s", got)
+	}
+}
+
+func TestLineItemDiscountAmountScales(t *testing.T) {
+	item := LineItem{Subtotal: NewDecimal(10, 0), Total: NewDecimal(7505, 3)}
+	if got := item.DiscountAmount(); got.String() != "2.495" {
+		t.Errorf("DiscountAmount() = %s, want 2.495", got)
+	}
+}
@@ -0,0 +1,56 @@
+// Package otelwc traces WooCommerce client requests with OpenTelemetry.
+//
+//	client.Use(otelwc.Middleware(otel.GetTracerProvider()))
+package otelwc
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/mikespook/wc-api-golang/woocommerce/otelwc"
+
+// Middleware returns a woocommerce.Middleware starting a client span for
+// every request attempt, named after the HTTP method and endpoint route,
+// and propagating the trace context in the request headers.
+func Middleware(tp trace.TracerProvider) woocommerce.Middleware {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	tracer := tp.Tracer(instrumentationName)
+	return func(next woocommerce.RoundTripFunc) woocommerce.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			route := woocommerce.RequestRoute(req)
+			ctx, span := tracer.Start(req.Context(), fmt.Sprintf("woocommerce %s %s", req.Method, route),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("http.route", route),
+					attribute.String("server.address", req.URL.Hostname()),
+				),
+			)
+			defer span.End()
+
+			req = req.WithContext(ctx)
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+			resp, err := next(req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			if resp.StatusCode >= 400 {
+				span.SetStatus(codes.Error, resp.Status)
+			}
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPerPage is the page size ListAll requests when params does not set
+// per_page. It is the largest page WooCommerce allows.
+const DefaultPerPage = 100
+
+// ListAll walks a list endpoint page by page, calling fn with the raw items
+// of each page until the listing is exhausted or fn returns an error.
+//
+// The total page count is re-read from every response, so items added or
+// removed while iterating do not cut the walk short; iteration also stops
+// on the first empty or short page.
+func (c *Client) ListAll(ctx context.Context, endpoint string, params url.Values, fn func(items []json.RawMessage) error) error {
+	return c.walk(ctx, endpoint, params, func(resp *Response) (int, error) {
+		var items []json.RawMessage
+		if err := json.NewDecoder(resp).Decode(&items); err != nil {
+			return 0, err
+		}
+		if len(items) == 0 {
+			return 0, nil
+		}
+		return len(items), fn(items)
+	})
+}
+
+// walk requests the pages of a list endpoint in turn and passes each
+// response to page, which returns the number of items it read.
+func (c *Client) walk(ctx context.Context, endpoint string, params url.Values, page func(resp *Response) (int, error)) error {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = append([]string(nil), v...)
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = DefaultPerPage
+		query.Set("per_page", strconv.Itoa(perPage))
+	}
+	n, err := strconv.Atoi(query.Get("page"))
+	if err != nil || n <= 0 {
+		n = 1
+	}
+	for ; ; n++ {
+		query.Set("page", strconv.Itoa(n))
+		resp, err := c.Get(ctx, endpoint, query)
+		if err != nil {
+			return err
+		}
+		count, err := page(resp)
+		resp.Close()
+		if err != nil {
+			return err
+		}
+		if count == 0 || count < perPage || (resp.TotalPages > 0 && n >= resp.TotalPages) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func newPagedServer(t *testing.T, total int, pages *[]string) *Client {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		*pages = append(*pages, q.Get("page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		w.Header().Set("X-WP-Total", strconv.Itoa(total))
+		w.Header().Set("X-WP-TotalPages", strconv.Itoa((total+perPage-1)/perPage))
+		var items []map[string]int
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+			items = append(items, map[string]int{"id": id})
+		}
+		if items == nil {
+			w.Write([]byte("[]"))
+			return
+		}
+		json.NewEncoder(w).Encode(items)
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestListAll(t *testing.T) {
+	var pages []string
+	client := newPagedServer(t, 7, &pages)
+	var ids []int
+	err := client.ListAll(context.Background(), "products", url.Values{"per_page": {"3"}}, func(items []json.RawMessage) error {
+		for _, raw := range items {
+			var item struct{ ID int }
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			ids = append(ids, item.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5 6 7]" {
+		t.Errorf("ids = %v", ids)
+	}
+	if fmt.Sprint(pages) != "[1 2 3]" {
+		t.Errorf("pages requested = %v, want [1 2 3]", pages)
+	}
+}
+
+func TestListAllStopsOnError(t *testing.T) {
+	var pages []string
+	client := newPagedServer(t, 10, &pages)
+	stop := errors.New("stop")
+	err := client.ListAll(context.Background(), "products", url.Values{"per_page": {"2"}}, func(items []json.RawMessage) error {
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("err = %v, want stop", err)
+	}
+	if len(pages) != 1 {
+		t.Errorf("pages requested = %v, want 1 page", pages)
+	}
+}
+
+func TestListAllEmpty(t *testing.T) {
+	var pages []string
+	client := newPagedServer(t, 0, &pages)
+	called := false
+	err := client.ListAll(context.Background(), "products", nil, func(items []json.RawMessage) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if called {
+		t.Error("fn called for an empty listing")
+	}
+}
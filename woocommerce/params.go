@@ -0,0 +1,158 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListParams builds the query string for list endpoints. Setters return
+// the receiver so calls can be chained; invalid values are reported by
+// Values.
+//
+//	params := NewListParams().Status("processing").PerPage(50).OrderBy("date").Order("asc")
+//
+// The zero value is ready to use.
+type ListParams struct {
+	values url.Values
+	errs   []string
+}
+
+func NewListParams() *ListParams {
+	return &ListParams{values: url.Values{}}
+}
+
+var (
+	listOrders   = []string{"asc", "desc"}
+	listOrderBys = []string{
+		"date", "date_gmt", "modified", "id", "include", "title", "slug",
+		"name", "price", "popularity", "rating", "menu_order", "registered_date",
+		"count", "term_group", "description", "email",
+	}
+)
+
+func (p *ListParams) invalid(format string, args ...interface{}) *ListParams {
+	p.errs = append(p.errs, fmt.Sprintf(format, args...))
+	return p
+}
+
+// Set sets an arbitrary parameter not covered by the typed setters.
+func (p *ListParams) Set(key, value string) *ListParams {
+	if p.values == nil {
+		p.values = url.Values{}
+	}
+	p.values.Set(key, value)
+	return p
+}
+
+func (p *ListParams) Page(page int) *ListParams {
+	if page < 1 {
+		return p.invalid("page must be at least 1, got %d", page)
+	}
+	return p.Set("page", strconv.Itoa(page))
+}
+
+func (p *ListParams) PerPage(n int) *ListParams {
+	if n < 1 || n > 100 {
+		return p.invalid("per_page must be between 1 and 100, got %d", n)
+	}
+	return p.Set("per_page", strconv.Itoa(n))
+}
+
+func (p *ListParams) Search(term string) *ListParams {
+	return p.Set("search", term)
+}
+
+func (p *ListParams) After(t time.Time) *ListParams {
+	return p.Set("after", t.Format(time.RFC3339))
+}
+
+func (p *ListParams) Before(t time.Time) *ListParams {
+	return p.Set("before", t.Format(time.RFC3339))
+}
+
+// Status filters by one or more statuses, e.g. "processing" for orders or
+// "publish" for products.
+func (p *ListParams) Status(statuses ...string) *ListParams {
+	return p.Set("status", strings.Join(statuses, ","))
+}
+
+func (p *ListParams) OrderBy(field string) *ListParams {
+	if !contains(listOrderBys, field) {
+		return p.invalid("orderby %q is not supported", field)
+	}
+	return p.Set("orderby", field)
+}
+
+func (p *ListParams) Order(order string) *ListParams {
+	if !contains(listOrders, order) {
+		return p.invalid("order must be asc or desc, got %q", order)
+	}
+	return p.Set("order", order)
+}
+
+func (p *ListParams) Include(ids ...int) *ListParams {
+	return p.Set("include", joinInts(ids))
+}
+
+func (p *ListParams) Exclude(ids ...int) *ListParams {
+	return p.Set("exclude", joinInts(ids))
+}
+
+// Category filters products by category ID. Combined with Search and
+// OrderBy("popularity") it scopes a storefront search to one category.
+func (p *ListParams) Category(id int) *ListParams {
+	return p.Set("category", strconv.Itoa(id))
+}
+
+// Parent filters by parent ID, e.g. to list the child orders of one or
+// more orders or the variations of products.
+func (p *ListParams) Parent(ids ...int) *ListParams {
+	return p.Set("parent", joinInts(ids))
+}
+
+func (p *ListParams) SKU(sku string) *ListParams {
+	return p.Set("sku", sku)
+}
+
+// Fields limits the response to the given fields, e.g. "id", "sku" and
+// "stock_quantity"; nested fields are written "meta_data.key". Decode such
+// responses with Partial or Field to tell absent fields from zero values.
+func (p *ListParams) Fields(fields ...string) *ListParams {
+	return p.Set("_fields", strings.Join(fields, ","))
+}
+
+// Values returns the query parameters, or an error describing every
+// invalid value that was set. A nil or zero ListParams has no parameters.
+func (p *ListParams) Values() (url.Values, error) {
+	if p == nil {
+		return url.Values{}, nil
+	}
+	if len(p.errs) > 0 {
+		return nil, fmt.Errorf("Invalid list parameters: %s", strings.Join(p.errs, "; "))
+	}
+	values := url.Values{}
+	for k, v := range p.values {
+		values[k] = append([]string(nil), v...)
+	}
+	return values, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func joinInts(ids []int) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.Itoa(id)
+	}
+	return strings.Join(strs, ",")
+}
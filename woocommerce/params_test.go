@@ -0,0 +1,81 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListParams(t *testing.T) {
+	after := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	values, err := NewListParams().
+		Status("processing", "on-hold").
+		Page(2).
+		PerPage(50).
+		After(after).
+		Before(after.Add(24*time.Hour)).
+		OrderBy("date").
+		Order("asc").
+		Search("shirt").
+		Include(1, 2, 3).
+		Exclude(4).
+		SKU("WOO-1").
+		Set("customer", "7").
+		Values()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "after=2024-01-02T03%3A04%3A05Z&before=2024-01-03T03%3A04%3A05Z&customer=7&exclude=4&include=1%2C2%2C3" +
+		"&order=asc&orderby=date&page=2&per_page=50&search=shirt&sku=WOO-1&status=processing%2Con-hold"
+	if got := values.Encode(); got != want {
+		t.Errorf("Encode() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestListParamsValidation(t *testing.T) {
+	_, err := NewListParams().PerPage(500).Page(0).Order("up").OrderBy("colour").Values()
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"per_page", "page", "order must be", `orderby "colour"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestListParamsNil(t *testing.T) {
+	var p *ListParams
+	values, err := p.Values()
+	if err != nil || len(values) != 0 {
+		t.Errorf("nil ListParams = %v, %v", values, err)
+	}
+}
+
+func TestListParamsZero(t *testing.T) {
+	var p ListParams
+	values, err := p.Values()
+	if err != nil || len(values) != 0 {
+		t.Errorf("zero ListParams = %v, %v", values, err)
+	}
+	values, err = p.PerPage(10).Status("publish").Values()
+	if err != nil || values.Encode() != "per_page=10&status=publish" {
+		t.Errorf("values = %v, %v", values, err)
+	}
+}
+
+func TestListParamsParent(t *testing.T) {
+	var query string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`[]`))
+	})
+	if _, _, err := client.Orders.List(context.Background(), NewListParams().Parent(727, 728)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "parent=727%2C728") {
+		t.Errorf("query = %s", query)
+	}
+}
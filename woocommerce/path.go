@@ -0,0 +1,34 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// buildPath joins endpoint segments with "/", escaping each one so that
+// slugs and other caller-supplied values cannot alter the path.
+func buildPath(segments ...interface{}) string {
+	parts := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		var s string
+		switch v := seg.(type) {
+		case string:
+			s = v
+		case int:
+			s = strconv.Itoa(v)
+		case int64:
+			s = strconv.FormatInt(v, 10)
+		default:
+			s = fmt.Sprint(v)
+		}
+		if s == "." || s == ".." {
+			s = strings.Repeat("%2E", len(s))
+		} else {
+			s = url.PathEscape(s)
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, "/")
+}
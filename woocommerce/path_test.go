@@ -0,0 +1,24 @@
+package woocommerce
+
+import "testing"
+
+func TestBuildPath(t *testing.T) {
+	cases := []struct {
+		segments []interface{}
+		want     string
+	}{
+		{[]interface{}{"orders"}, "orders"},
+		{[]interface{}{"orders", 12, "notes", 34}, "orders/12/notes/34"},
+		{[]interface{}{"products", int64(7), "variations"}, "products/7/variations"},
+		{[]interface{}{"settings", "general", "woocommerce_currency"}, "settings/general/woocommerce_currency"},
+		{[]interface{}{"products", "categories", "a/b?c=d"}, "products/categories/a%2Fb%3Fc=d"},
+		{[]interface{}{"tags", "hello world#1"}, "tags/hello%20world%231"},
+		{[]interface{}{"tags", "../orders"}, "tags/..%2Forders"},
+		{[]interface{}{"tags", "..", "orders"}, "tags/%2E%2E/orders"},
+	}
+	for _, tc := range cases {
+		if got := buildPath(tc.segments...); got != tc.want {
+			t.Errorf("buildPath(%v) = %q, want %q", tc.segments, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// PaymentGatewaysService manages /payment_gateways. Gateways cannot be
+// created or deleted through the API, only listed and configured.
+type PaymentGatewaysService service
+
+type PaymentGateway struct {
+	ID                string                   `json:"id"`
+	Title             string                   `json:"title"`
+	Description       string                   `json:"description"`
+	Enabled           bool                     `json:"enabled"`
+	MethodTitle       string                   `json:"method_title"`
+	MethodDescription string                   `json:"method_description"`
+	MethodSupports    []string                 `json:"method_supports"`
+	Settings          map[string]MethodSetting `json:"settings"`
+}
+
+// PaymentGatewayUpdate changes a gateway. Nil fields are left unchanged;
+// Settings maps setting IDs to their new values.
+type PaymentGatewayUpdate struct {
+	Title       *string           `json:"title,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Order       *int              `json:"order,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Settings    map[string]string `json:"settings,omitempty"`
+}
+
+func (s *PaymentGatewaysService) List(ctx context.Context) ([]PaymentGateway, error) {
+	var gateways []PaymentGateway
+	_, err := s.client.list(ctx, "payment_gateways", nil, &gateways)
+	return gateways, err
+}
+
+func (s *PaymentGatewaysService) Get(ctx context.Context, id string) (*PaymentGateway, error) {
+	gateway := new(PaymentGateway)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("payment_gateways", id), nil, nil, gateway)
+	return gateway, err
+}
+
+func (s *PaymentGatewaysService) Update(ctx context.Context, id string, update *PaymentGatewayUpdate) (*PaymentGateway, error) {
+	updated := new(PaymentGateway)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("payment_gateways", id), nil, update, updated)
+	return updated, err
+}
+
+// SetEnabled enables or disables a gateway, e.g. "cod".
+func (s *PaymentGatewaysService) SetEnabled(ctx context.Context, id string, enabled bool) (*PaymentGateway, error) {
+	return s.Update(ctx, id, &PaymentGatewayUpdate{Enabled: &enabled})
+}
@@ -0,0 +1,88 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const gatewayJSON = `{
+	"id": "cod",
+	"title": "Cash on delivery",
+	"description": "Pay with cash upon delivery.",
+	"order": "",
+	"enabled": false,
+	"method_title": "Cash on delivery",
+	"method_description": "Have your customers pay with cash (or by other means) upon delivery.",
+	"method_supports": ["products"],
+	"settings": {
+		"instructions": {"id": "instructions", "label": "Instructions", "type": "textarea", "value": "Pay with cash upon delivery.", "default": "Pay with cash upon delivery."},
+		"enable_for_virtual": {"id": "enable_for_virtual", "label": "Accept COD if the order is virtual", "type": "checkbox", "value": "yes", "default": "yes"}
+	}
+}`
+
+func TestPaymentGatewaysGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/payment_gateways/cod" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(gatewayJSON))
+	})
+	gateway, err := client.PaymentGateways.Get(context.Background(), "cod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gateway.ID != "cod" || gateway.Enabled || gateway.MethodSupports[0] != "products" {
+		t.Errorf("gateway = %+v", gateway)
+	}
+	if gateway.Settings["enable_for_virtual"].Value != "yes" {
+		t.Errorf("settings = %+v", gateway.Settings)
+	}
+}
+
+func TestPaymentGatewaysUpdate(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s", r.Method)
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		w.Write([]byte(gatewayJSON))
+	})
+	ctx := context.Background()
+	if _, err := client.PaymentGateways.SetEnabled(ctx, "cod", true); err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != 1 || body["enabled"] != true {
+		t.Errorf("SetEnabled body = %v", body)
+	}
+	title := "Pay on delivery"
+	update := &PaymentGatewayUpdate{Title: &title, Settings: map[string]string{"enable_for_virtual": "no"}}
+	if _, err := client.PaymentGateways.Update(ctx, "cod", update); err != nil {
+		t.Fatal(err)
+	}
+	settings, _ := body["settings"].(map[string]interface{})
+	if body["title"] != title || settings["enable_for_virtual"] != "no" {
+		t.Errorf("Update body = %v", body)
+	}
+	if _, ok := body["enabled"]; ok {
+		t.Errorf("unset enabled was sent: %v", body)
+	}
+}
+
+func TestPaymentGatewaysList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[" + gatewayJSON + "]"))
+	})
+	gateways, err := client.PaymentGateways.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gateways) != 1 || gateways[0].Title != "Cash on delivery" {
+		t.Errorf("gateways = %+v", gateways)
+	}
+}
@@ -0,0 +1,294 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ProductsService manages /products. Fields left at their zero value are
+// omitted from create and update requests.
+type ProductsService service
+
+type Product struct {
+	ID                int                `json:"id,omitempty"`
+	Name              string             `json:"name,omitempty"`
+	Slug              string             `json:"slug,omitempty"`
+	Permalink         string             `json:"permalink,omitempty"`
+	DateCreated       WCTime             `json:"date_created,omitzero"`
+	DateCreatedGMT    WCTime             `json:"date_created_gmt,omitzero"`
+	DateModified      WCTime             `json:"date_modified,omitzero"`
+	DateModifiedGMT   WCTime             `json:"date_modified_gmt,omitzero"`
+	Type              string             `json:"type,omitempty"`
+	Status            string             `json:"status,omitempty"`
+	Featured          *bool              `json:"featured,omitempty"`
+	CatalogVisibility string             `json:"catalog_visibility,omitempty"`
+	Description       string             `json:"description,omitempty"`
+	ShortDescription  string             `json:"short_description,omitempty"`
+	SKU               string             `json:"sku,omitempty"`
+	Price             Decimal            `json:"price,omitzero"`
+	RegularPrice      Decimal            `json:"regular_price,omitzero"`
+	SalePrice         Decimal            `json:"sale_price,omitzero"`
+	DateOnSaleFrom    WCTime             `json:"date_on_sale_from,omitzero"`
+	DateOnSaleFromGMT WCTime             `json:"date_on_sale_from_gmt,omitzero"`
+	DateOnSaleTo      WCTime             `json:"date_on_sale_to,omitzero"`
+	DateOnSaleToGMT   WCTime             `json:"date_on_sale_to_gmt,omitzero"`
+	PriceHTML         string             `json:"price_html,omitempty"`
+	OnSale            bool               `json:"on_sale,omitempty"`
+	Purchasable       bool               `json:"purchasable,omitempty"`
+	TotalSales        int                `json:"total_sales,omitempty"`
+	Virtual           *bool              `json:"virtual,omitempty"`
+	Downloadable      *bool              `json:"downloadable,omitempty"`
+	Downloads         []ProductDownload  `json:"downloads,omitempty"`
+	DownloadLimit     int                `json:"download_limit,omitempty"`
+	DownloadExpiry    int                `json:"download_expiry,omitempty"`
+	ExternalURL       string             `json:"external_url,omitempty"`
+	ButtonText        string             `json:"button_text,omitempty"`
+	TaxStatus         string             `json:"tax_status,omitempty"`
+	TaxClass          string             `json:"tax_class,omitempty"`
+	ManageStock       *bool              `json:"manage_stock,omitempty"`
+	StockQuantity     *int               `json:"stock_quantity,omitempty"`
+	StockStatus       string             `json:"stock_status,omitempty"`
+	Backorders        string             `json:"backorders,omitempty"`
+	BackordersAllowed bool               `json:"backorders_allowed,omitempty"`
+	Backordered       bool               `json:"backordered,omitempty"`
+	SoldIndividually  *bool              `json:"sold_individually,omitempty"`
+	Weight            string             `json:"weight,omitempty"`
+	Dimensions        *Dimensions        `json:"dimensions,omitempty"`
+	ShippingRequired  bool               `json:"shipping_required,omitempty"`
+	ShippingTaxable   bool               `json:"shipping_taxable,omitempty"`
+	ShippingClass     string             `json:"shipping_class,omitempty"`
+	ShippingClassID   int                `json:"shipping_class_id,omitempty"`
+	ReviewsAllowed    *bool              `json:"reviews_allowed,omitempty"`
+	AverageRating     string             `json:"average_rating,omitempty"`
+	RatingCount       int                `json:"rating_count,omitempty"`
+	ParentID          int                `json:"parent_id,omitempty"`
+	PurchaseNote      string             `json:"purchase_note,omitempty"`
+	MenuOrder         int                `json:"menu_order,omitempty"`
+	Categories        []ProductTermRef   `json:"categories,omitempty"`
+	Tags              []ProductTermRef   `json:"tags,omitempty"`
+	Images            []ProductImage     `json:"images,omitempty"`
+	Attributes        []ProductAttribute `json:"attributes,omitempty"`
+	DefaultAttributes []ProductAttribute `json:"default_attributes,omitempty"`
+	Variations        []int              `json:"variations,omitempty"`
+	GroupedProducts   []int              `json:"grouped_products,omitempty"`
+	// RelatedIDs lists products related by category or tag. It is
+	// read-only; upsells and cross-sells are set with UpsellIDs and
+	// CrossSellIDs.
+	RelatedIDs   []int `json:"related_ids,omitempty"`
+	UpsellIDs    []int `json:"upsell_ids,omitempty"`
+	CrossSellIDs []int `json:"cross_sell_ids,omitempty"`
+	MetaData     Meta  `json:"meta_data,omitempty"`
+}
+
+type Dimensions struct {
+	Length string `json:"length"`
+	Width  string `json:"width"`
+	Height string `json:"height"`
+}
+
+type ProductDownload struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	File string `json:"file,omitempty"`
+}
+
+// ProductTermRef references a product category or tag. Only ID is needed
+// when assigning terms to a product.
+type ProductTermRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+type ProductImage struct {
+	ID              int    `json:"id,omitempty"`
+	DateCreated     WCTime `json:"date_created,omitzero"`
+	DateCreatedGMT  WCTime `json:"date_created_gmt,omitzero"`
+	DateModified    WCTime `json:"date_modified,omitzero"`
+	DateModifiedGMT WCTime `json:"date_modified_gmt,omitzero"`
+	Src             string `json:"src,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Alt             string `json:"alt,omitempty"`
+}
+
+// ProductAttribute is used both for a product's attributes, where Options
+// lists the values, and for default attributes, where Option holds one.
+type ProductAttribute struct {
+	ID        int      `json:"id,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Position  int      `json:"position,omitempty"`
+	Visible   *bool    `json:"visible,omitempty"`
+	Variation *bool    `json:"variation,omitempty"`
+	Options   []string `json:"options,omitempty"`
+	Option    string   `json:"option,omitempty"`
+}
+
+func (s *ProductsService) List(ctx context.Context, params *ListParams) ([]Product, *Response, error) {
+	var products []Product
+	resp, err := s.client.list(ctx, "products", params, &products)
+	return products, resp, err
+}
+
+// ListAll calls fn with every page of products matching params.
+func (s *ProductsService) ListAll(ctx context.Context, params *ListParams, fn func([]Product) error) error {
+	return listAll(ctx, s.client, "products", params, fn)
+}
+
+// Stream calls fn with every product matching params, decoding them one at a
+// time as they arrive; see Client.Stream.
+func (s *ProductsService) Stream(ctx context.Context, params *ListParams, fn func(*Product) error) error {
+	return streamList(ctx, s.client, "products", params, fn)
+}
+
+// BulkFetch calls fn with every page of products matching params, fetching
+// pages concurrently; see Client.BulkFetch.
+func (s *ProductsService) BulkFetch(ctx context.Context, params *ListParams, opts *BulkOptions, fn func([]Product) error) error {
+	return bulkFetch(ctx, s.client, "products", params, opts, fn)
+}
+
+func (s *ProductsService) Get(ctx context.Context, id int) (*Product, error) {
+	product := new(Product)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", id), nil, nil, product)
+	return product, err
+}
+
+func (s *ProductsService) Create(ctx context.Context, product *Product) (*Product, error) {
+	created := new(Product)
+	_, err := s.client.call(ctx, http.MethodPost, "products", nil, product, created)
+	return created, err
+}
+
+func (s *ProductsService) Update(ctx context.Context, id int, product *Product) (*Product, error) {
+	updated := new(Product)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", id), nil, product, updated)
+	return updated, err
+}
+
+// Delete moves a product to the trash, or deletes it permanently when
+// force is true. It returns the deleted product.
+func (s *ProductsService) Delete(ctx context.Context, id int, force bool) (*Product, error) {
+	deleted := new(Product)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductsService) Batch(ctx context.Context, req *BatchRequest[Product]) (*BatchResponse[Product], error) {
+	return batch(ctx, s.client, "products/batch", req)
+}
+
+// menuOrder is a product update that sends menu_order even when it is 0.
+type menuOrder struct {
+	ID        int `json:"id"`
+	MenuOrder int `json:"menu_order"`
+}
+
+// Reorder sets the menu_order of the products to their index in
+// orderedIDs with batch updates, so the catalog sorts in that order.
+func (s *ProductsService) Reorder(ctx context.Context, orderedIDs []int) error {
+	req := new(BatchRequest[menuOrder])
+	for i, id := range orderedIDs {
+		req.Update = append(req.Update, menuOrder{ID: id, MenuOrder: i})
+	}
+	resp, err := batch(ctx, s.client, "products/batch", req)
+	if err != nil {
+		return err
+	}
+	return resp.Err()
+}
+
+// PriceRange returns the lowest and highest effective price, the sale
+// price while on sale and the regular price otherwise, across the
+// product's variations. Products without variations return their own
+// price as both bounds; variations without a price are skipped.
+func (s *ProductsService) PriceRange(ctx context.Context, productID int) (min, max Decimal, err error) {
+	seen := false
+	add := func(price Decimal) {
+		if price.IsZero() {
+			return
+		}
+		if !seen || price.Cmp(min) < 0 {
+			min = price
+		}
+		if !seen || price.Cmp(max) > 0 {
+			max = price
+		}
+		seen = true
+	}
+	err = s.client.ProductVariations.ListAll(ctx, productID, nil, func(variations []ProductVariation) error {
+		for _, v := range variations {
+			add(effectivePrice(v.OnSale, v.SalePrice, v.RegularPrice))
+		}
+		return nil
+	})
+	if err != nil || seen {
+		return min, max, err
+	}
+	product, err := s.Get(ctx, productID)
+	if err != nil {
+		return min, max, err
+	}
+	add(effectivePrice(product.OnSale, product.SalePrice, product.RegularPrice))
+	return min, max, nil
+}
+
+// TotalStock returns the stock of a product: its own stock quantity, or
+// for a variable product the sum over the variations that manage their
+// stock. Variations that do not are left out of the sum. managed is false
+// when no stock is tracked at all, in which case total is zero. A variable
+// product that manages stock only at product level reports its own stock.
+func (s *ProductsService) TotalStock(ctx context.Context, productID int) (total int, managed bool, err error) {
+	product, err := s.Get(ctx, productID)
+	if err != nil {
+		return 0, false, err
+	}
+	if product.Type == "variable" {
+		err = s.client.ProductVariations.ListAll(ctx, productID, nil, func(variations []ProductVariation) error {
+			for _, v := range variations {
+				if isTrue(v.ManageStock) && v.StockQuantity != nil {
+					total += *v.StockQuantity
+					managed = true
+				}
+			}
+			return nil
+		})
+		if err != nil || managed {
+			return total, managed, err
+		}
+	}
+	if isTrue(product.ManageStock) && product.StockQuantity != nil {
+		return *product.StockQuantity, true, nil
+	}
+	return 0, false, nil
+}
+
+// IndexByMeta streams the products matching params and maps the value of
+// their metaKey meta, e.g. an ERP's own SKU, to the product ID. Products
+// without the meta, or with an empty value, are left out. Two products
+// with the same value make the index ambiguous, so IndexByMeta returns an
+// error naming both.
+func (s *ProductsService) IndexByMeta(ctx context.Context, metaKey string, params *ListParams) (map[string]int, error) {
+	index := make(map[string]int)
+	err := s.Stream(ctx, params, func(product *Product) error {
+		value := product.MetaData.String(metaKey)
+		if value == "" {
+			return nil
+		}
+		if id, ok := index[value]; ok {
+			return fmt.Errorf("Products %d and %d have the same %s: %q", id, product.ID, metaKey, value)
+		}
+		index[value] = product.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func effectivePrice(onSale bool, sale, regular Decimal) Decimal {
+	if onSale && !sale.IsZero() {
+		return sale
+	}
+	return regular
+}
@@ -0,0 +1,332 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const productJSON = `{
+	"id": 794,
+	"name": "Premium Quality",
+	"slug": "premium-quality-19",
+	"type": "simple",
+	"status": "publish",
+	"featured": false,
+	"sku": "PQ-19",
+	"price": "21.99",
+	"regular_price": "21.99",
+	"sale_price": "",
+	"on_sale": false,
+	"manage_stock": true,
+	"stock_quantity": 12,
+	"weight": "0.5",
+	"dimensions": {"length": "10", "width": "5", "height": "2"},
+	"categories": [{"id": 9, "name": "Clothing", "slug": "clothing"}],
+	"tags": [],
+	"images": [{"id": 792, "src": "https://example.com/T_2_front-4.jpg", "name": "", "alt": ""}],
+	"attributes": [{"id": 6, "name": "Color", "position": 0, "visible": true, "variation": true, "options": ["Black", "Green"]}],
+	"meta_data": [{"id": 1, "key": "_erp_sku", "value": "ERP-1"}]
+}`
+
+func TestProductsGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/wp-json/wc/v3/products/794" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(productJSON))
+	})
+	product, err := client.Products.Get(context.Background(), 794)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.ID != 794 || product.Name != "Premium Quality" || product.RegularPrice.String() != "21.99" {
+		t.Errorf("unexpected product: %+v", product)
+	}
+	if product.StockQuantity == nil || *product.StockQuantity != 12 {
+		t.Errorf("StockQuantity = %v, want 12", product.StockQuantity)
+	}
+	if product.Dimensions == nil || product.Dimensions.Length != "10" {
+		t.Errorf("Dimensions = %+v", product.Dimensions)
+	}
+	if len(product.Categories) != 1 || product.Categories[0].Slug != "clothing" {
+		t.Errorf("Categories = %+v", product.Categories)
+	}
+	if len(product.Attributes) != 1 || len(product.Attributes[0].Options) != 2 {
+		t.Errorf("Attributes = %+v", product.Attributes)
+	}
+	if len(product.MetaData) != 1 || product.MetaData[0].Value != "ERP-1" {
+		t.Errorf("MetaData = %+v", product.MetaData)
+	}
+}
+
+func TestProductsList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("status"); got != "publish" {
+			t.Errorf("status = %q, want publish", got)
+		}
+		w.Header().Set("X-WP-Total", "1")
+		w.Header().Set("X-WP-TotalPages", "1")
+		w.Write([]byte("[" + productJSON + "]"))
+	})
+	products, resp, err := client.Products.List(context.Background(), NewListParams().Status("publish"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || products[0].ID != 794 {
+		t.Errorf("products = %+v", products)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Total = %d, want 1", resp.Total)
+	}
+}
+
+func TestProductsListSearchInCategory(t *testing.T) {
+	var query url.Values
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte("[]"))
+	})
+	params := NewListParams().Search("hoodie").Category(15).OrderBy("popularity").Order("desc")
+	if _, _, err := client.Products.List(context.Background(), params); err != nil {
+		t.Fatal(err)
+	}
+	for k := range query {
+		if strings.HasPrefix(k, "oauth_") {
+			query.Del(k)
+		}
+	}
+	if got, want := query.Encode(), "category=15&order=desc&orderby=popularity&search=hoodie"; got != want {
+		t.Errorf("query = %s, want %s", got, want)
+	}
+}
+
+func TestProductsCreateUpdate(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(productJSON))
+	})
+	created, err := client.Products.Create(context.Background(), &Product{
+		Name:         "Premium Quality",
+		Type:         "simple",
+		RegularPrice: MustParseDecimal("21.99"),
+		Categories:   []ProductTermRef{{ID: 9}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/products" || created.ID != 794 {
+		t.Errorf("create sent %s %s, got id %d", method, path, created.ID)
+	}
+	if body["name"] != "Premium Quality" || body["regular_price"] != "21.99" {
+		t.Errorf("create body = %v", body)
+	}
+	if _, ok := body["id"]; ok {
+		t.Errorf("create body includes zero id: %v", body)
+	}
+
+	if _, err := client.Products.Update(context.Background(), 794, &Product{SalePrice: MustParseDecimal("19.99")}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/products/794" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+	if len(body) != 1 || body["sale_price"] != "19.99" {
+		t.Errorf("update body = %v, want only sale_price", body)
+	}
+
+	// Flags set to false are sent; unset flags are left alone.
+	if _, err := client.Products.Update(context.Background(), 794, &Product{Featured: Bool(false), ManageStock: Bool(true)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != 2 || body["featured"] != false || body["manage_stock"] != true {
+		t.Errorf("update body = %v, want featured false and manage_stock true", body)
+	}
+}
+
+func TestProductsDelete(t *testing.T) {
+	var method, force string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, force = r.Method, r.URL.Query().Get("force")
+		w.Write([]byte(productJSON))
+	})
+	deleted, err := client.Products.Delete(context.Background(), 794, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || force != "true" || deleted.ID != 794 {
+		t.Errorf("delete sent %s force=%q, got id %d", method, force, deleted.ID)
+	}
+}
+
+func TestProductsListAll(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("X-WP-TotalPages", "2")
+			w.Write([]byte(`[{"id":1},{"id":2}]`))
+			return
+		}
+		w.Header().Set("X-WP-TotalPages", "2")
+		w.Write([]byte(`[{"id":3}]`))
+	})
+	var ids []int
+	err := client.Products.ListAll(context.Background(), NewListParams().PerPage(2), func(products []Product) error {
+		for _, p := range products {
+			ids = append(ids, p.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Errorf("ids = %v", ids)
+	}
+}
+
+func TestProductsPriceRange(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wp-json/wc/v3/products/22/variations":
+			w.Header().Set("X-WP-TotalPages", "2")
+			if r.URL.Query().Get("page") == "1" {
+				// A full page, so that the second one is requested.
+				page := strings.Repeat(`{"regular_price":"25.00","sale_price":"","on_sale":false},`, DefaultPerPage-1)
+				w.Write([]byte(`[` + page + `{"id":2,"regular_price":"12.00","sale_price":"10.00","on_sale":true}]`))
+				return
+			}
+			w.Write([]byte(`[{"id":3,"regular_price":"30.00","sale_price":"20.00","on_sale":false},{"id":4,"regular_price":""}]`))
+		case "/wp-json/wc/v3/products/31/variations":
+			w.Write([]byte(`[]`))
+		case "/wp-json/wc/v3/products/31":
+			w.Write([]byte(`{"id":31,"regular_price":"8.50","sale_price":"","on_sale":false}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	min, max, err := client.Products.PriceRange(ctx, 22)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if min.String() != "10.00" || max.String() != "30.00" {
+		t.Errorf("range = %s - %s, want 10.00 - 30.00", min, max)
+	}
+
+	min, max, err = client.Products.PriceRange(ctx, 31)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if min.String() != "8.50" || max.String() != "8.50" {
+		t.Errorf("range without variations = %s - %s", min, max)
+	}
+}
+
+func TestProductsTotalStock(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wp-json/wc/v3/products/1":
+			w.Write([]byte(`{"id":1,"type":"simple","manage_stock":true,"stock_quantity":7}`))
+		case "/wp-json/wc/v3/products/2":
+			w.Write([]byte(`{"id":2,"type":"simple","manage_stock":false,"stock_quantity":null}`))
+		case "/wp-json/wc/v3/products/22":
+			w.Write([]byte(`{"id":22,"type":"variable","manage_stock":false,"variations":[23,24,25]}`))
+		case "/wp-json/wc/v3/products/22/variations":
+			w.Write([]byte(`[{"id":23,"manage_stock":true,"stock_quantity":5},{"id":24,"manage_stock":false,"stock_quantity":null},{"id":25,"manage_stock":true,"stock_quantity":3}]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	for id, want := range map[int]struct {
+		total   int
+		managed bool
+	}{1: {7, true}, 2: {0, false}, 22: {8, true}} {
+		total, managed, err := client.Products.TotalStock(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != want.total || managed != want.managed {
+			t.Errorf("product %d: stock = %d, %v, want %d, %v", id, total, managed, want.total, want.managed)
+		}
+	}
+}
+
+func TestProductsLinkedIDs(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id":794,"related_ids":[31,22,369],"upsell_ids":[],"cross_sell_ids":[53,49]}`))
+	})
+	product, err := client.Products.Update(context.Background(), 794, &Product{CrossSellIDs: []int{53, 49}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, _ := body["cross_sell_ids"].([]interface{})
+	if len(body) != 1 || len(ids) != 2 || ids[0] != 53.0 || ids[1] != 49.0 {
+		t.Errorf("update body = %v, want only cross_sell_ids", body)
+	}
+	if len(product.RelatedIDs) != 3 || len(product.UpsellIDs) != 0 || product.CrossSellIDs[1] != 49 {
+		t.Errorf("product = %+v", product)
+	}
+}
+
+func TestProductsIndexByMeta(t *testing.T) {
+	products := `[
+		{"id":1,"meta_data":[{"id":10,"key":"_erp_sku","value":"ERP-1"}]},
+		{"id":2,"meta_data":[]},
+		{"id":3,"meta_data":[{"id":11,"key":"_erp_sku","value":"ERP-3"},{"id":12,"key":"_other","value":"x"}]},
+		{"id":4,"meta_data":[{"id":13,"key":"_erp_sku","value":""}]}
+	]`
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(products))
+	})
+	ctx := context.Background()
+	index, err := client.Products.IndexByMeta(ctx, "_erp_sku", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index) != 2 || index["ERP-1"] != 1 || index["ERP-3"] != 3 {
+		t.Errorf("index = %v", index)
+	}
+
+	products = `[{"id":1,"meta_data":[{"key":"_erp_sku","value":"ERP-1"}]},{"id":5,"meta_data":[{"key":"_erp_sku","value":"ERP-1"}]}]`
+	if _, err := client.Products.IndexByMeta(ctx, "_erp_sku", nil); err == nil || !strings.Contains(err.Error(), "Products 1 and 5") {
+		t.Errorf("duplicate err = %v", err)
+	}
+}
+
+func TestProductsReorder(t *testing.T) {
+	var path string
+	var body struct {
+		Update []map[string]int `json:"update"`
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"update":[{"id":31,"menu_order":0},{"id":12,"menu_order":1},{"id":7,"menu_order":2}]}`))
+	})
+	ids := []int{31, 12, 7}
+	if err := client.Products.Reorder(context.Background(), ids); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/products/batch" || len(body.Update) != len(ids) {
+		t.Fatalf("sent %s %v", path, body.Update)
+	}
+	for i, id := range ids {
+		order, ok := body.Update[i]["menu_order"]
+		if body.Update[i]["id"] != id || !ok || order != i {
+			t.Errorf("update %d = %v, want id %d menu_order %d", i, body.Update[i], id, i)
+		}
+	}
+}
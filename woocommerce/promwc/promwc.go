@@ -0,0 +1,60 @@
+// Package promwc records WooCommerce client metrics with Prometheus.
+//
+//	client, err := woocommerce.NewClient(store, ck, cs, &woocommerce.Option{
+//		Metrics: promwc.NewMetrics(prometheus.DefaultRegisterer),
+//	})
+package promwc
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements woocommerce.Metrics.
+type Metrics struct {
+	duration *prometheus.HistogramVec
+	requests *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+}
+
+// NewMetrics creates the collectors and registers them with reg, if reg is
+// not nil.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "woocommerce",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of WooCommerce API request attempts.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "woocommerce",
+			Name:      "requests_total",
+			Help:      "WooCommerce API request attempts by response status; status is \"error\" when no response was received.",
+		}, []string{"method", "route", "status"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "woocommerce",
+			Name:      "retries_total",
+			Help:      "WooCommerce API request retries.",
+		}, []string{"method", "route"}),
+	}
+	if reg != nil {
+		reg.MustRegister(m.duration, m.requests, m.retries)
+	}
+	return m
+}
+
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	code := "error"
+	if status != 0 {
+		code = strconv.Itoa(status)
+	}
+	m.duration.WithLabelValues(method, route).Observe(duration.Seconds())
+	m.requests.WithLabelValues(method, route, code).Inc()
+}
+
+func (m *Metrics) ObserveRetry(method, route string) {
+	m.retries.WithLabelValues(method, route).Inc()
+}
@@ -0,0 +1,39 @@
+package promwc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ woocommerce.Metrics = (*Metrics)(nil)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewMetrics(reg)
+	m.ObserveRequest("GET", "orders/{id}", 503, 20*time.Millisecond)
+	m.ObserveRetry("GET", "orders/{id}")
+	m.ObserveRequest("GET", "orders/{id}", 200, 10*time.Millisecond)
+	m.ObserveRequest("POST", "products", 0, time.Second)
+
+	expected := `
+# HELP woocommerce_requests_total WooCommerce API request attempts by response status; status is "error" when no response was received.
+# TYPE woocommerce_requests_total counter
+woocommerce_requests_total{method="GET",route="orders/{id}",status="200"} 1
+woocommerce_requests_total{method="GET",route="orders/{id}",status="503"} 1
+woocommerce_requests_total{method="POST",route="products",status="error"} 1
+# HELP woocommerce_retries_total WooCommerce API request retries.
+# TYPE woocommerce_retries_total counter
+woocommerce_retries_total{method="GET",route="orders/{id}"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "woocommerce_requests_total", "woocommerce_retries_total"); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(m.duration); n != 2 {
+		t.Errorf("duration series = %d, want 2", n)
+	}
+}
@@ -0,0 +1,54 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(50, 2)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Two requests fit the burst; the other three wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 55*time.Millisecond {
+		t.Errorf("5 requests took %s, want at least 60ms", elapsed)
+	}
+}
+
+func TestRateLimiterContext(t *testing.T) {
+	l := newRateLimiter(0.1, 1)
+	l.Wait(context.Background())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{RateLimit: 40, RateBurst: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		body, err := client.Get(context.Background(), "products", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 45*time.Millisecond {
+		t.Errorf("3 requests took %s, want at least 50ms", elapsed)
+	}
+}
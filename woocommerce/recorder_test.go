@@ -0,0 +1,152 @@
+package woocommerce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	const product = `{"id":794,"name":"Premium Quality"}`
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-WP-Total", "1")
+		w.Write([]byte(product))
+	})
+	client, err := NewClient(srv.URL, "ck_secret", "cs_secret", &Option{Recorder: &FileRecorder{Dir: dir}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := client.Get(context.Background(), "products/794", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(body)
+	body.Close()
+	if string(got) != product {
+		t.Fatalf("recorded body = %q, want %q", got, product)
+	}
+
+	fixture, err := os.ReadFile(filepath.Join(dir, "0001.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(fixture), "ck_secret") || strings.Contains(string(fixture), "oauth_signature") {
+		t.Fatalf("fixture contains credentials: %s", fixture)
+	}
+	srv.Close()
+
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = NewClient(srv.URL, "other_ck", "other_cs", &Option{HTTPClient: &http.Client{Transport: replayer}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err = client.Get(context.Background(), "products/794", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ = io.ReadAll(body)
+	body.Close()
+	if string(got) != product {
+		t.Fatalf("replayed body = %q, want %q", got, product)
+	}
+	if _, err := client.Get(context.Background(), "products/795", nil); err == nil {
+		t.Fatal("expected error for unrecorded request")
+	}
+}
+
+func TestRecorderScrubsSecrets(t *testing.T) {
+	dir := t.TempDir()
+	srv := newTestServer(t, true, func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "cookie_secret"})
+		w.Write([]byte(`{"id":142,"name":"Order created","secret":"webhook_secret","delivery_url":"https://example.com/hooks"}`))
+	})
+	option := &Option{
+		ForceAuthMode: AuthModeBasicHeader,
+		HTTPClient:    srv.Client(),
+		Recorder: &FileRecorder{Dir: dir, Scrub: func(in *Interaction) {
+			in.Body = strings.ReplaceAll(in.Body, "example.com", "example.test")
+		}},
+	}
+	client, err := NewClient(srv.URL, "ck_secret", "cs_secret", option)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Webhooks.Create(context.Background(), &Webhook{Name: "Order created", Secret: "webhook_secret"}); err != nil {
+		t.Fatal(err)
+	}
+	fixture, err := os.ReadFile(filepath.Join(dir, "0001.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"ck_secret", "Y2tfc2VjcmV0", "webhook_secret", "cookie_secret", "example.com"} {
+		if strings.Contains(string(fixture), secret) {
+			t.Errorf("fixture contains %s: %s", secret, fixture)
+		}
+	}
+	if !strings.Contains(string(fixture), "REDACTED") || !strings.Contains(string(fixture), "User-Agent") {
+		t.Errorf("fixture = %s", fixture)
+	}
+}
+
+func TestVCR(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	calls := 0
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"id":794,"name":"Premium Quality"}`))
+	})
+	get := func(mode VCRMode) (*Product, error) {
+		option, err := VCR(dir, mode, &Option{Language: "fr"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if option.Language != "fr" {
+			t.Errorf("option not copied: %+v", option)
+		}
+		client, err := NewClient(srv.URL, "ck", "cs", option)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client.Products.Get(context.Background(), 794)
+	}
+
+	// The first run records, later runs replay without reaching the store.
+	for i := 0; i < 2; i++ {
+		product, err := get(VCRAuto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if product.ID != 794 || calls != 1 {
+			t.Fatalf("run %d: product = %+v after %d calls", i, product, calls)
+		}
+	}
+	if _, err := get(VCRRecord); err != nil || calls != 2 {
+		t.Fatalf("re-record: %v after %d calls", err, calls)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*.json")); len(files) != 1 {
+		t.Errorf("fixtures = %v", files)
+	}
+}
+
+func TestRecorderError(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	})
+	dir := filepath.Join(t.TempDir(), "missing")
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Recorder: &FileRecorder{Dir: dir}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Get(context.Background(), "products", nil)
+	if err == nil || !strings.Contains(err.Error(), "Recording GET /wp-json/wc/v3/products") {
+		t.Errorf("err = %v, want recording error", err)
+	}
+}
@@ -0,0 +1,75 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// RefundsService manages /orders/{id}/refunds.
+//
+// A full refund sets Amount to the order total. A partial refund sets a
+// smaller Amount, optionally itemised with LineItems so stock and line
+// totals are adjusted.
+type RefundsService service
+
+type Refund struct {
+	ID              int              `json:"id,omitempty"`
+	DateCreated     WCTime           `json:"date_created,omitzero"`
+	DateCreatedGMT  WCTime           `json:"date_created_gmt,omitzero"`
+	Amount          Decimal          `json:"amount,omitzero"`
+	Reason          string           `json:"reason,omitempty"`
+	RefundedBy      int              `json:"refunded_by,omitempty"`
+	RefundedPayment bool             `json:"refunded_payment,omitempty"`
+	MetaData        Meta             `json:"meta_data,omitempty"`
+	LineItems       []RefundLineItem `json:"line_items,omitempty"`
+	// APIRefund asks the payment gateway to refund the payment as well.
+	// APIRestock returns refunded items to stock. Both are write-only.
+	APIRefund  *bool `json:"api_refund,omitempty"`
+	APIRestock *bool `json:"api_restock,omitempty"`
+}
+
+// RefundLineItem refers to an order line item by ID. RefundTotal and
+// RefundTax are only used when creating a refund.
+type RefundLineItem struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name,omitempty"`
+	ProductID   int         `json:"product_id,omitempty"`
+	VariationID int         `json:"variation_id,omitempty"`
+	Quantity    int         `json:"quantity,omitempty"`
+	Subtotal    Decimal     `json:"subtotal,omitzero"`
+	Total       Decimal     `json:"total,omitzero"`
+	TotalTax    Decimal     `json:"total_tax,omitzero"`
+	RefundTotal Decimal     `json:"refund_total,omitzero"`
+	RefundTax   []RefundTax `json:"refund_tax,omitempty"`
+}
+
+type RefundTax struct {
+	ID          int     `json:"id"`
+	RefundTotal Decimal `json:"refund_total"`
+}
+
+func (s *RefundsService) List(ctx context.Context, orderID int, params *ListParams) ([]Refund, *Response, error) {
+	var refunds []Refund
+	resp, err := s.client.list(ctx, buildPath("orders", orderID, "refunds"), params, &refunds)
+	return refunds, resp, err
+}
+
+func (s *RefundsService) Get(ctx context.Context, orderID, refundID int) (*Refund, error) {
+	refund := new(Refund)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("orders", orderID, "refunds", refundID), nil, nil, refund)
+	return refund, err
+}
+
+func (s *RefundsService) Create(ctx context.Context, orderID int, refund *Refund) (*Refund, error) {
+	created := new(Refund)
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("orders", orderID, "refunds"), nil, refund, created)
+	return created, err
+}
+
+// Delete deletes a refund. Refunds cannot be trashed, so the request is
+// always forced.
+func (s *RefundsService) Delete(ctx context.Context, orderID, refundID int) (*Refund, error) {
+	deleted := new(Refund)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("orders", orderID, "refunds", refundID), forceParam(true), nil, deleted)
+	return deleted, err
+}
@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const refundJSON = `{
+	"id": 726,
+	"date_created": "2017-03-21T17:07:11",
+	"amount": "10.00",
+	"reason": "",
+	"refunded_by": 1,
+	"refunded_payment": false,
+	"meta_data": [],
+	"line_items": [{"id": 314, "name": "Woo Album #2", "product_id": 87, "quantity": -1, "subtotal": "-9.00", "total": "-9.00"}]
+}`
+
+func TestRefundsCreate(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(refundJSON))
+	})
+	ctx := context.Background()
+	restock := true
+
+	// Full refund of the order total.
+	if _, err := client.Refunds.Create(ctx, 723, &Refund{Amount: MustParseDecimal("30.00"), Reason: "cancelled"}); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/orders/723/refunds" || body["amount"] != "30.00" || body["line_items"] != nil {
+		t.Errorf("full refund sent %s %v", path, body)
+	}
+
+	// Partial refund of one line item.
+	refund, err := client.Refunds.Create(ctx, 723, &Refund{
+		Amount:     MustParseDecimal("10.00"),
+		APIRestock: &restock,
+		LineItems: []RefundLineItem{{
+			ID:          314,
+			Quantity:    1,
+			RefundTotal: NewDecimal(9, 0),
+			RefundTax:   []RefundTax{{ID: 75, RefundTotal: NewDecimal(1, 0)}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund.ID != 726 || refund.LineItems[0].Quantity != -1 {
+		t.Errorf("refund = %+v", refund)
+	}
+	items := body["line_items"].([]interface{})
+	item := items[0].(map[string]interface{})
+	if body["api_restock"] != true || item["id"] != 314.0 || item["refund_total"] != "9" {
+		t.Errorf("partial refund body = %v", body)
+	}
+	if _, ok := body["api_refund"]; ok {
+		t.Errorf("unset api_refund was sent: %v", body)
+	}
+}
+
+func TestRefundsListGetDelete(t *testing.T) {
+	var method, path, force string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, force = r.Method, r.URL.Path, r.URL.Query().Get("force")
+		if r.URL.Path == "/wp-json/wc/v3/orders/723/refunds" {
+			w.Write([]byte("[" + refundJSON + "]"))
+			return
+		}
+		w.Write([]byte(refundJSON))
+	})
+	ctx := context.Background()
+	refunds, _, err := client.Refunds.List(ctx, 723, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refunds) != 1 || refunds[0].Amount.String() != "10.00" {
+		t.Errorf("refunds = %+v", refunds)
+	}
+	if _, err := client.Refunds.Get(ctx, 723, 726); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/orders/723/refunds/726" {
+		t.Errorf("get path = %s", path)
+	}
+	if _, err := client.Refunds.Delete(ctx, 723, 726); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || force != "true" {
+		t.Errorf("delete sent %s force=%q", method, force)
+	}
+}
@@ -0,0 +1,132 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ReportsService reads the reports at /reports. When Option.Cache is set,
+// sales and top sellers reports are cached and later requests for them are
+// sent with If-Modified-Since, so that a 304 Not Modified answer is served
+// from the cache.
+type ReportsService service
+
+type Report struct {
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
+// ReportParams selects the date range of a sales or top sellers report.
+// Either set Period or DateMin and DateMax; the zero value reports on the
+// current week.
+type ReportParams struct {
+	Period  string
+	DateMin time.Time
+	DateMax time.Time
+}
+
+// Report periods.
+const (
+	ReportPeriodWeek      = "week"
+	ReportPeriodMonth     = "month"
+	ReportPeriodLastMonth = "last_month"
+	ReportPeriodYear      = "year"
+)
+
+func (p *ReportParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	query := url.Values{}
+	if p.Period != "" {
+		query.Set("period", p.Period)
+	}
+	if !p.DateMin.IsZero() {
+		query.Set("date_min", p.DateMin.Format("2006-01-02"))
+	}
+	if !p.DateMax.IsZero() {
+		query.Set("date_max", p.DateMax.Format("2006-01-02"))
+	}
+	return query
+}
+
+type SalesReport struct {
+	TotalSales      string                      `json:"total_sales"`
+	NetSales        string                      `json:"net_sales"`
+	AverageSales    string                      `json:"average_sales"`
+	TotalOrders     int                         `json:"total_orders"`
+	TotalItems      int                         `json:"total_items"`
+	TotalTax        string                      `json:"total_tax"`
+	TotalShipping   string                      `json:"total_shipping"`
+	TotalRefunds    float64                     `json:"total_refunds"`
+	TotalDiscount   string                      `json:"total_discount"`
+	TotalsGroupedBy string                      `json:"totals_grouped_by"`
+	Totals          map[string]SalesReportTotal `json:"totals"`
+	TotalCustomers  int                         `json:"total_customers"`
+}
+
+// SalesReportTotal holds the totals of one day or month of a sales report,
+// keyed by date in SalesReport.Totals.
+type SalesReportTotal struct {
+	Sales     string `json:"sales"`
+	Orders    int    `json:"orders"`
+	Items     int    `json:"items"`
+	Tax       string `json:"tax"`
+	Shipping  string `json:"shipping"`
+	Discount  string `json:"discount"`
+	Customers int    `json:"customers"`
+}
+
+type TopSeller struct {
+	Title     string `json:"title"`
+	ProductID int    `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// ReportTotal is a count from one of the /reports/*/totals endpoints, e.g.
+// the number of orders with Slug "processing".
+type ReportTotal struct {
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Total int    `json:"total"`
+}
+
+// List lists the available reports.
+func (s *ReportsService) List(ctx context.Context) ([]Report, error) {
+	var reports []Report
+	_, err := s.client.call(ctx, http.MethodGet, "reports", nil, nil, &reports)
+	return reports, err
+}
+
+func (s *ReportsService) Sales(ctx context.Context, params *ReportParams) (*SalesReport, error) {
+	reports, err := getReport[[]SalesReport](ctx, s, "reports/sales", params.values())
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return new(SalesReport), nil
+	}
+	return &reports[0], nil
+}
+
+func (s *ReportsService) TopSellers(ctx context.Context, params *ReportParams) ([]TopSeller, error) {
+	return getReport[[]TopSeller](ctx, s, "reports/top_sellers", params.values())
+}
+
+// Totals reads /reports/{resource}/totals, where resource is one of
+// "orders", "products", "customers", "coupons" or "reviews".
+func (s *ReportsService) Totals(ctx context.Context, resource string) ([]ReportTotal, error) {
+	var totals []ReportTotal
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("reports", resource, "totals"), nil, nil, &totals)
+	return totals, err
+}
+
+// getReport reads a report. Reports rarely carry a Last-Modified header,
+// so the time of the previous request stands in for it.
+func getReport[T any](ctx context.Context, s *ReportsService, endpoint string, query url.Values) (T, error) {
+	var report T
+	_, err := s.client.call(withRevalidateByTime(ctx), http.MethodGet, endpoint, query, nil, &report)
+	return report, err
+}
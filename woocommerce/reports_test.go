@@ -0,0 +1,122 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportsSales(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/reports/sales" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("date_min") != "2024-01-01" || q.Get("date_max") != "2024-01-31" || q.Has("period") {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`[{"total_sales":"1200.00","net_sales":"1100.00","average_sales":"38.71","total_orders":12,"total_items":30,"total_tax":"0.00","total_shipping":"100.00","total_refunds":0,"total_discount":"0.00","totals_grouped_by":"day","totals":{"2024-01-01":{"sales":"40.00","orders":1,"items":2,"tax":"0.00","shipping":"5.00","discount":"0.00","customers":0}},"total_customers":4}]`))
+	})
+	report, err := client.Reports.Sales(context.Background(), &ReportParams{
+		DateMin: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateMax: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.TotalSales != "1200.00" || report.TotalOrders != 12 || report.Totals["2024-01-01"].Orders != 1 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestReportsTopSellers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("period"); got != "last_month" {
+			t.Errorf("period = %q", got)
+		}
+		w.Write([]byte(`[{"title":"Happy Ninja","product_id":37,"quantity":9}]`))
+	})
+	sellers, err := client.Reports.TopSellers(context.Background(), &ReportParams{Period: ReportPeriodLastMonth})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sellers) != 1 || sellers[0].ProductID != 37 || sellers[0].Quantity != 9 {
+		t.Errorf("sellers = %+v", sellers)
+	}
+}
+
+func TestReportsTotals(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/reports/orders/totals" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"slug":"pending","name":"Pending payment","total":7},{"slug":"processing","name":"Processing","total":3}]`))
+	})
+	totals, err := client.Reports.Totals(context.Background(), "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(totals) != 2 || totals[1].Slug != "processing" || totals[1].Total != 3 {
+		t.Errorf("totals = %+v", totals)
+	}
+}
+
+func TestReportsNotModified(t *testing.T) {
+	var requests int
+	var since string
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if since = r.Header.Get("If-Modified-Since"); since != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`[{"total_sales":"1200.00","total_orders":12}]`))
+	})
+	var logs bytes.Buffer
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{
+		Cache:          NewMemoryCache(),
+		LogHandler:     slog.NewTextHandler(&logs, nil),
+		CircuitBreaker: &CircuitBreaker{Threshold: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	params := &ReportParams{Period: ReportPeriodWeek}
+	report, err := client.Reports.Sales(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report.TotalOrders = 0
+	var again *SalesReport
+	for i := 0; i < 3; i++ {
+		// 304s are successes and do not trip the breaker.
+		if again, err = client.Reports.Sales(ctx, params); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if strings.Contains(logs.String(), "level=WARN") {
+		t.Errorf("304 logged as a failure: %s", logs.String())
+	}
+	if requests != 4 || since == "" {
+		t.Fatalf("requests = %d, If-Modified-Since = %q", requests, since)
+	}
+	if _, err := http.ParseTime(since); err != nil {
+		t.Errorf("If-Modified-Since = %q: %v", since, err)
+	}
+	if again == report || again.TotalOrders != 12 {
+		t.Errorf("304 returned %+v, want a copy of the cached report", again)
+	}
+
+	// Other periods are not sent conditionally.
+	if _, err := client.Reports.Sales(ctx, &ReportParams{Period: ReportPeriodMonth}); err != nil {
+		t.Fatal(err)
+	}
+	if since != "" {
+		t.Errorf("other period sent If-Modified-Since = %q", since)
+	}
+}
@@ -0,0 +1,55 @@
+package woocommerce
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// Response is the body of a successful request together with its status
+// and headers. Reading and closing it reads and closes the body.
+type Response struct {
+	io.ReadCloser
+	StatusCode int
+	Header     http.Header
+	// Total and TotalPages come from the X-WP-Total and X-WP-TotalPages
+	// headers sent with list responses. They are zero when absent.
+	Total      int
+	TotalPages int
+
+	deprecations []string
+}
+
+func newResponse(resp *http.Response) *Response {
+	total, _ := strconv.Atoi(resp.Header.Get("X-WP-Total"))
+	totalPages, _ := strconv.Atoi(resp.Header.Get("X-WP-TotalPages"))
+	return &Response{
+		ReadCloser: resp.Body,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Total:      total,
+		TotalPages: totalPages,
+
+		deprecations: deprecations(resp.Header),
+	}
+}
+
+// Deprecations returns the deprecation notices sent with the response,
+// which WordPress adds when a request uses a deprecated function, argument
+// or field. They are also logged at warn level when Option.LogHandler is
+// set, for requests made with Do too.
+func (r *Response) Deprecations() []string {
+	return r.deprecations
+}
+
+// deprecationHeaders leaves out the generic Warning header, which proxies
+// and caches also send, e.g. for stale responses.
+var deprecationHeaders = []string{"X-WP-DeprecatedFunction", "X-WP-DeprecatedParam"}
+
+func deprecations(h http.Header) []string {
+	var notices []string
+	for _, name := range deprecationHeaders {
+		notices = append(notices, h.Values(name)...)
+	}
+	return notices
+}
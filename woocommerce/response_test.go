@@ -0,0 +1,88 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResponsePagination(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-WP-Total", "42")
+		w.Header().Set("X-WP-TotalPages", "5")
+		w.Write([]byte(`[{"id":1},{"id":2}]`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "products", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Close()
+	if resp.Total != 42 || resp.TotalPages != 5 {
+		t.Errorf("Total, TotalPages = %d, %d, want 42, 5", resp.Total, resp.TotalPages)
+	}
+	var items []map[string]interface{}
+	if err := json.NewDecoder(resp).Decode(&items); err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 {
+		t.Errorf("decoded %d items, want 2", len(items))
+	}
+}
+
+func TestResponseWithoutPagination(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "products/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+	if resp.Total != 0 || resp.TotalPages != 0 || resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response metadata: %+v", resp)
+	}
+}
+
+func TestResponseDeprecations(t *testing.T) {
+	const notice = "WC_Product::get_price_html (since 9.0; use wc_price instead)"
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-WP-DeprecatedFunction", notice)
+		w.Header().Set("Warning", `110 proxy "Response is Stale"`)
+		w.Write([]byte(`{"id":1}`))
+	})
+	var buf bytes.Buffer
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{LogHandler: slog.NewTextHandler(&buf, nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(context.Background(), "products/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Close()
+	if got := resp.Deprecations(); len(got) != 1 || got[0] != notice {
+		t.Errorf("deprecations = %q", got)
+	}
+
+	buf.Reset()
+	raw, err := client.Do(context.Background(), http.MethodGet, "products/1", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw.Body.Close()
+	if !strings.Contains(buf.String(), `level=WARN msg="woocommerce deprecation" method=GET endpoint=products/1`) || !strings.Contains(buf.String(), "use wc_price instead") {
+		t.Errorf("log output = %s", buf.String())
+	}
+}
@@ -0,0 +1,135 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Network errors and
+// responses with a retryable status are retried with exponential backoff,
+// or after the delay given by a Retry-After header; context cancellation
+// is never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BackoffBase is the delay before the first retry. It doubles on each
+	// further attempt, up to MaxBackoff. Defaults to 500ms.
+	BackoffBase time.Duration
+	// MaxBackoff caps the delay between attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+	// MaxRetryAfter caps the delay a Retry-After header may ask for;
+	// requests asked to wait longer fail with the *APIError instead,
+	// unless Option.MaxMaintenanceWait allows a maintenance pause.
+	// Defaults to 5m.
+	MaxRetryAfter time.Duration
+	// RetryableStatuses lists the response statuses that are retried.
+	// Defaults to 429, 500, 502, 503 and 504. NewClient rejects 2xx
+	// statuses, which are never retried.
+	RetryableStatuses []int
+}
+
+var defaultRetryableStatuses = []int{429, 500, 502, 503, 504}
+
+func (p *RetryPolicy) retryable(status int) bool {
+	statuses := defaultRetryableStatuses
+	if p.RetryableStatuses != nil {
+		statuses = p.RetryableStatuses
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// next reports whether a request that failed with err on the given attempt
+// should be retried, and how long to wait first.
+func (p *RetryPolicy) next(attempt int, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if !p.retryable(apiErr.StatusCode) {
+			return 0, false
+		}
+		if apiErr.RetryAfter > 0 {
+			return apiErr.RetryAfter, apiErr.RetryAfter <= p.maxRetryAfter()
+		}
+	}
+	return p.backoff(attempt), true
+}
+
+func (p *RetryPolicy) maxRetryAfter() time.Duration {
+	if p == nil || p.MaxRetryAfter <= 0 {
+		return 5 * time.Minute
+	}
+	return p.MaxRetryAfter
+}
+
+// maintenancePause returns how long to pause before resending a request
+// answered as by a store in maintenance mode: 503 with a Retry-After
+// longer than MaxRetryAfter. waited is the time already paused for the
+// request; pauses stop once it reaches Option.MaxMaintenanceWait.
+func (c *Client) maintenancePause(err error, waited time.Duration) (time.Duration, bool) {
+	max := c.option.MaxMaintenanceWait
+	var apiErr *APIError
+	if max <= waited || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	if apiErr.RetryAfter <= c.option.Retry.maxRetryAfter() {
+		return 0, false
+	}
+	return min(apiErr.RetryAfter, max-waited), true
+}
+
+func (p *RetryPolicy) validate() error {
+	if p == nil {
+		return nil
+	}
+	for _, status := range p.RetryableStatuses {
+		if status >= 200 && status < 300 {
+			return fmt.Errorf("Retryable status %d is a success status", status)
+		}
+	}
+	return nil
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	base, max := p.BackoffBase, p.MaxBackoff
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	// Up to 20% jitter keeps parallel workers from retrying in lockstep.
+	return delay - time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,221 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryClient(t *testing.T, policy *RetryPolicy, statuses ...int) (*Client, *int32) {
+	var calls int32
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost && string(body) != `{"name":"x"}` {
+			t.Errorf("attempt %d body = %q", n, body)
+		}
+		if int(n) <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Retry: policy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &calls
+}
+
+func TestRetrySucceeds(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond}
+	client, calls := newRetryClient(t, policy, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	body, err := client.Post(context.Background(), "products", strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if *calls != 3 {
+		t.Errorf("calls = %d, want 3", *calls)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 2, BackoffBase: time.Millisecond}
+	client, calls := newRetryClient(t, policy, 500, 500, 500)
+	_, err := client.Get(context.Background(), "products", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 500 {
+		t.Fatalf("err = %v, want 500 APIError", err)
+	}
+	if *calls != 2 {
+		t.Errorf("calls = %d, want 2", *calls)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond}
+	client, calls := newRetryClient(t, policy, http.StatusNotFound)
+	if _, err := client.Get(context.Background(), "products/1", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if *calls != 1 {
+		t.Errorf("calls = %d, want 1", *calls)
+	}
+}
+
+func TestRetryCustomStatuses(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond, RetryableStatuses: []int{http.StatusConflict}}
+	client, calls := newRetryClient(t, policy, http.StatusConflict)
+	body, err := client.Get(context.Background(), "products", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if *calls != 2 {
+		t.Errorf("calls = %d, want 2", *calls)
+	}
+
+	client, calls = newRetryClient(t, policy, http.StatusServiceUnavailable)
+	if _, err := client.Get(context.Background(), "products", nil); err == nil {
+		t.Fatal("expected 503 not to be retried")
+	}
+	if *calls != 1 {
+		t.Errorf("calls = %d, want 1", *calls)
+	}
+}
+
+func TestRetryStatusesValidated(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, RetryableStatuses: []int{http.StatusConflict, http.StatusAccepted}}
+	_, err := NewClient("https://example.com", "ck", "cs", &Option{Retry: policy})
+	if err == nil || !strings.Contains(err.Error(), "202") {
+		t.Fatalf("err = %v, want 202 rejected", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{BackoffBase: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 400 * time.Millisecond, 6: time.Second} {
+		got := p.backoff(attempt)
+		if got > want || got < want*4/5 {
+			t.Errorf("backoff(%d) = %s, want within 20%% below %s", attempt, got, want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	for value, want := range map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"-1":                            0,
+		"soon":                          0,
+		"Sat, 01 Jun 2024 09:00:30 GMT": 30 * time.Second,
+		"Sat, 01 Jun 2024 08:59:00 GMT": 0,
+	} {
+		if got := parseRetryAfter(value, now); got != want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var calls int32
+	retryAfter := "1"
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	policy := &RetryPolicy{MaxAttempts: 2, BackoffBase: time.Millisecond}
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Retry: policy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	body, err := client.Get(context.Background(), "products", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least 1s", elapsed)
+	}
+
+	// A hint longer than MaxRetryAfter gives up instead of waiting.
+	atomic.StoreInt32(&calls, 0)
+	retryAfter = "3600"
+	_, err = client.Get(context.Background(), "products", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != time.Hour {
+		t.Fatalf("err = %v, want 429 APIError with RetryAfter", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("calls = %d, want 1", n)
+	}
+}
+
+func TestMaintenancePause(t *testing.T) {
+	var calls, down int32 = 0, 1
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.AddInt32(&down, -1) >= 0 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	var logs bytes.Buffer
+	option := &Option{
+		Retry:              &RetryPolicy{MaxAttempts: 1, MaxRetryAfter: 10 * time.Millisecond},
+		MaxMaintenanceWait: 2 * time.Second,
+		LogHandler:         slog.NewTextHandler(&logs, nil),
+	}
+	client, err := NewClient(srv.URL, "ck", "cs", option)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	body, err := client.Get(context.Background(), "products", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("recovered after %s, want at least 1s", elapsed)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("calls = %d, want 2", n)
+	}
+	if !strings.Contains(logs.String(), "woocommerce maintenance") {
+		t.Errorf("pause not logged: %s", logs.String())
+	}
+
+	// Pauses stop once MaxMaintenanceWait is used up.
+	atomic.StoreInt32(&calls, 0)
+	atomic.StoreInt32(&down, 10)
+	option.MaxMaintenanceWait = 20 * time.Millisecond
+	client, err = NewClient(srv.URL, "ck", "cs", option)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Get(context.Background(), "products", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("err = %v, want 503 APIError", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("calls = %d, want 2", n)
+	}
+}
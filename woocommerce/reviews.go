@@ -0,0 +1,91 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// ProductReviewsService manages /products/reviews.
+type ProductReviewsService service
+
+type ProductReview struct {
+	ID             int    `json:"id,omitempty"`
+	DateCreated    WCTime `json:"date_created,omitzero"`
+	DateCreatedGMT WCTime `json:"date_created_gmt,omitzero"`
+	ProductID      int    `json:"product_id,omitempty"`
+	ProductName    string `json:"product_name,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Reviewer       string `json:"reviewer,omitempty"`
+	ReviewerEmail  string `json:"reviewer_email,omitempty"`
+	Review         string `json:"review,omitempty"`
+	Rating         int    `json:"rating,omitempty"`
+	Verified       bool   `json:"verified,omitempty"`
+}
+
+// Review statuses. ReviewStatusUnspam and ReviewStatusUntrash are only
+// valid in updates.
+const (
+	ReviewStatusApproved = "approved"
+	ReviewStatusHold     = "hold"
+	ReviewStatusSpam     = "spam"
+	ReviewStatusUnspam   = "unspam"
+	ReviewStatusTrash    = "trash"
+	ReviewStatusUntrash  = "untrash"
+)
+
+func (s *ProductReviewsService) List(ctx context.Context, params *ListParams) ([]ProductReview, *Response, error) {
+	var reviews []ProductReview
+	resp, err := s.client.list(ctx, "products/reviews", params, &reviews)
+	return reviews, resp, err
+}
+
+// ListAll calls fn with every page of reviews matching params.
+func (s *ProductReviewsService) ListAll(ctx context.Context, params *ListParams, fn func([]ProductReview) error) error {
+	return listAll(ctx, s.client, "products/reviews", params, fn)
+}
+
+func (s *ProductReviewsService) Get(ctx context.Context, id int) (*ProductReview, error) {
+	review := new(ProductReview)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "reviews", id), nil, nil, review)
+	return review, err
+}
+
+func (s *ProductReviewsService) Create(ctx context.Context, review *ProductReview) (*ProductReview, error) {
+	created := new(ProductReview)
+	_, err := s.client.call(ctx, http.MethodPost, "products/reviews", nil, review, created)
+	return created, err
+}
+
+func (s *ProductReviewsService) Update(ctx context.Context, id int, review *ProductReview) (*ProductReview, error) {
+	updated := new(ProductReview)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "reviews", id), nil, review, updated)
+	return updated, err
+}
+
+// Delete moves a review to the trash, or deletes it permanently when force
+// is true. It returns the deleted review.
+func (s *ProductReviewsService) Delete(ctx context.Context, id int, force bool) (*ProductReview, error) {
+	deleted := new(ProductReview)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "reviews", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductReviewsService) Batch(ctx context.Context, req *BatchRequest[ProductReview]) (*BatchResponse[ProductReview], error) {
+	return batch(ctx, s.client, "products/reviews/batch", req)
+}
+
+// Approve publishes a review.
+func (s *ProductReviewsService) Approve(ctx context.Context, id int) (*ProductReview, error) {
+	return s.Update(ctx, id, &ProductReview{Status: ReviewStatusApproved})
+}
+
+// Spam marks a review as spam.
+func (s *ProductReviewsService) Spam(ctx context.Context, id int) (*ProductReview, error) {
+	return s.Update(ctx, id, &ProductReview{Status: ReviewStatusSpam})
+}
+
+// Trash moves a review to the trash. Trashed reviews can be restored by
+// updating their status to ReviewStatusUntrash.
+func (s *ProductReviewsService) Trash(ctx context.Context, id int) (*ProductReview, error) {
+	return s.Update(ctx, id, &ProductReview{Status: ReviewStatusTrash})
+}
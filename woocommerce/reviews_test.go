@@ -0,0 +1,83 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const reviewJSON = `{"id":22,"date_created":"2018-10-18T17:59:17","product_id":22,"status":"approved","reviewer":"John Doe","reviewer_email":"john.doe@example.com","review":"Nice album!","rating":5,"verified":false}`
+
+func TestProductReviewsCreate(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/wp-json/wc/v3/products/reviews" {
+			t.Errorf("create sent %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(reviewJSON))
+	})
+	review, err := client.ProductReviews.Create(context.Background(), &ProductReview{
+		ProductID:     22,
+		Review:        "Nice album!",
+		Reviewer:      "John Doe",
+		ReviewerEmail: "john.doe@example.com",
+		Rating:        5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.ID != 22 || review.Rating != 5 || review.Status != ReviewStatusApproved {
+		t.Errorf("review = %+v", review)
+	}
+	if body["product_id"] != float64(22) || body["rating"] != float64(5) {
+		t.Errorf("body = %v", body)
+	}
+}
+
+func TestProductReviewsModeration(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s", r.Method)
+		}
+		path = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		w.Write([]byte(reviewJSON))
+	})
+	ctx := context.Background()
+	for _, tc := range []struct {
+		fn     func(context.Context, int) (*ProductReview, error)
+		status string
+	}{
+		{client.ProductReviews.Approve, ReviewStatusApproved},
+		{client.ProductReviews.Spam, ReviewStatusSpam},
+		{client.ProductReviews.Trash, ReviewStatusTrash},
+	} {
+		if _, err := tc.fn(ctx, 22); err != nil {
+			t.Fatal(err)
+		}
+		if path != "/wp-json/wc/v3/products/reviews/22" || len(body) != 1 || body["status"] != tc.status {
+			t.Errorf("%s: sent %s %v", tc.status, path, body)
+		}
+	}
+}
+
+func TestProductReviewsDelete(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("force") != "" {
+			t.Errorf("delete sent %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(reviewJSON))
+	})
+	if _, err := client.ProductReviews.Delete(context.Background(), 22, false); err != nil {
+		t.Fatal(err)
+	}
+}
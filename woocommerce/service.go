@@ -0,0 +1,81 @@
+package woocommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// service is embedded by the typed resource services.
+type service struct {
+	client *Client
+}
+
+// call sends in as a JSON body, if it is not nil, and decodes the response
+// into out, if it is not nil.
+func (c *Client) call(ctx context.Context, method, endpoint string, query url.Values, in, out interface{}) (*Response, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	resp, err := c.request(ctx, method, endpoint, query, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	if out != nil {
+		if err := json.NewDecoder(resp).Decode(out); err != nil && err != io.EOF {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) list(ctx context.Context, endpoint string, params *ListParams, out interface{}) (*Response, error) {
+	query, err := params.Values()
+	if err != nil {
+		return nil, err
+	}
+	return c.call(ctx, http.MethodGet, endpoint, query, nil, out)
+}
+
+func listAll[T any](ctx context.Context, c *Client, endpoint string, params *ListParams, fn func([]T) error) error {
+	query, err := params.Values()
+	if err != nil {
+		return err
+	}
+	return c.ListAll(ctx, endpoint, query, func(items []json.RawMessage) error {
+		page := make([]T, len(items))
+		for i, raw := range items {
+			if err := json.Unmarshal(raw, &page[i]); err != nil {
+				return err
+			}
+		}
+		return fn(page)
+	})
+}
+
+func forceParam(force bool) url.Values {
+	if !force {
+		return nil
+	}
+	return url.Values{"force": {"true"}}
+}
+
+// Bool returns a pointer to v. Writable flags of the models are pointers
+// so that false can be sent; nil leaves them unchanged.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// isTrue reports whether an optional flag is set to true.
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
@@ -0,0 +1,144 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// SettingsService reads and updates the store settings at /settings.
+// Settings are organised in groups such as "general" or "products", each
+// holding a list of options.
+type SettingsService service
+
+type SettingsGroup struct {
+	ID          string   `json:"id"`
+	Label       string   `json:"label,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	SubGroups   []string `json:"sub_groups,omitempty"`
+}
+
+// SettingOption is a single setting. Value is a string for most types and
+// a list of strings for multiselect options.
+type SettingOption struct {
+	ID          string            `json:"id"`
+	Label       string            `json:"label,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Value       interface{}       `json:"value"`
+	Default     interface{}       `json:"default,omitempty"`
+	Tip         string            `json:"tip,omitempty"`
+	Placeholder string            `json:"placeholder,omitempty"`
+	Options     map[string]string `json:"options,omitempty"`
+	GroupID     string            `json:"group_id,omitempty"`
+}
+
+// Groups lists the settings groups.
+func (s *SettingsService) Groups(ctx context.Context) ([]SettingsGroup, error) {
+	var groups []SettingsGroup
+	_, err := s.client.call(ctx, http.MethodGet, "settings", nil, nil, &groups)
+	return groups, err
+}
+
+// List lists the options of a group.
+func (s *SettingsService) List(ctx context.Context, group string) ([]SettingOption, error) {
+	var options []SettingOption
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("settings", group), nil, nil, &options)
+	return options, err
+}
+
+func (s *SettingsService) Get(ctx context.Context, group, id string) (*SettingOption, error) {
+	option := new(SettingOption)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("settings", group, id), nil, nil, option)
+	return option, err
+}
+
+// Update sets the value of an option.
+func (s *SettingsService) Update(ctx context.Context, group, id string, value interface{}) (*SettingOption, error) {
+	updated := new(SettingOption)
+	body := map[string]interface{}{"value": value}
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("settings", group, id), nil, body, updated)
+	return updated, err
+}
+
+// UpdateGroup sets several options of a group in a batch. values maps
+// option IDs to their new values. The updated options are returned sorted
+// by ID, along with the first option that failed to update, if any.
+func (s *SettingsService) UpdateGroup(ctx context.Context, group string, values map[string]interface{}) ([]SettingOption, error) {
+	req := &BatchRequest[SettingOption]{}
+	for id, value := range values {
+		req.Update = append(req.Update, SettingOption{ID: id, Value: value})
+	}
+	sort.Slice(req.Update, func(i, j int) bool { return req.Update[i].ID < req.Update[j].ID })
+	resp, err := batch(ctx, s.client, buildPath("settings", group, "batch"), req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Update, resp.Err()
+}
+
+// TaxConfig is the store's general tax configuration, spread over the
+// "general" and "tax" settings groups.
+type TaxConfig struct {
+	// CalcTaxes enables tax rates and calculations,
+	// woocommerce_calc_taxes.
+	CalcTaxes bool
+	// PricesIncludeTax is set when prices are entered inclusive of tax,
+	// woocommerce_prices_include_tax.
+	PricesIncludeTax bool
+	// TaxBasedOn is the address taxes are calculated from, "shipping",
+	// "billing" or "base", woocommerce_tax_based_on.
+	TaxBasedOn string
+	// RoundAtSubtotal rounds tax at subtotal level instead of per line,
+	// woocommerce_tax_round_at_subtotal.
+	RoundAtSubtotal bool
+}
+
+// TaxConfig reads the tax configuration.
+func (s *SettingsService) TaxConfig(ctx context.Context) (*TaxConfig, error) {
+	values := map[string]interface{}{}
+	for _, group := range []string{"general", "tax"} {
+		options, err := s.List(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			values[option.ID] = option.Value
+		}
+	}
+	taxBasedOn, _ := values["woocommerce_tax_based_on"].(string)
+	return &TaxConfig{
+		CalcTaxes:        values["woocommerce_calc_taxes"] == "yes",
+		PricesIncludeTax: values["woocommerce_prices_include_tax"] == "yes",
+		TaxBasedOn:       taxBasedOn,
+		RoundAtSubtotal:  values["woocommerce_tax_round_at_subtotal"] == "yes",
+	}, nil
+}
+
+// UpdateTaxConfig writes every field of config. TaxBasedOn is left
+// unchanged when empty.
+func (s *SettingsService) UpdateTaxConfig(ctx context.Context, config *TaxConfig) error {
+	if _, err := s.UpdateGroup(ctx, "general", map[string]interface{}{
+		"woocommerce_calc_taxes": yesNo(config.CalcTaxes),
+	}); err != nil {
+		return err
+	}
+	values := map[string]interface{}{
+		"woocommerce_prices_include_tax":    yesNo(config.PricesIncludeTax),
+		"woocommerce_tax_round_at_subtotal": yesNo(config.RoundAtSubtotal),
+	}
+	if config.TaxBasedOn != "" {
+		values["woocommerce_tax_based_on"] = config.TaxBasedOn
+	}
+	_, err := s.UpdateGroup(ctx, "tax", values)
+	return err
+}
+
+// yesNo formats b as WooCommerce stores checkbox settings.
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,130 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSettingsList(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wp-json/wc/v3/settings":
+			w.Write([]byte(`[{"id":"general","label":"General","sub_groups":[]},{"id":"email_new_order","label":"New order","parent_id":"email"}]`))
+		case "/wp-json/wc/v3/settings/general":
+			w.Write([]byte(`[{"id":"woocommerce_currency","label":"Currency","type":"select","value":"USD","default":"GBP","options":{"USD":"United States (US) dollar"}},{"id":"woocommerce_specific_allowed_countries","type":"multiselect","value":["US","CA"],"default":""}]`))
+		default:
+			t.Errorf("path = %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	groups, err := client.Settings.Groups(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || groups[1].ParentID != "email" {
+		t.Errorf("groups = %+v", groups)
+	}
+	options, err := client.Settings.List(ctx, "general")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(options) != 2 || options[0].Value != "USD" || options[0].Options["USD"] == "" {
+		t.Errorf("options = %+v", options)
+	}
+	if countries, ok := options[1].Value.([]interface{}); !ok || len(countries) != 2 {
+		t.Errorf("multiselect value = %#v", options[1].Value)
+	}
+}
+
+func TestSettingsUpdate(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"update":[{"id":"woocommerce_currency","value":"EUR"},{"id":"woocommerce_price_num_decimals","value":"2"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":"woocommerce_currency","value":"EUR","group_id":"general"}`))
+	})
+	ctx := context.Background()
+	option, err := client.Settings.Update(ctx, "general", "woocommerce_currency", "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/settings/general/woocommerce_currency" || body["value"] != "EUR" || option.GroupID != "general" {
+		t.Errorf("update %s %v = %+v", path, body, option)
+	}
+	options, err := client.Settings.UpdateGroup(ctx, "general", map[string]interface{}{
+		"woocommerce_price_num_decimals": "2",
+		"woocommerce_currency":           "EUR",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/wp-json/wc/v3/settings/general/batch" || len(options) != 2 {
+		t.Errorf("batch %s = %+v", path, options)
+	}
+	update, _ := body["update"].([]interface{})
+	if len(update) != 2 || update[0].(map[string]interface{})["id"] != "woocommerce_currency" {
+		t.Errorf("batch body = %v", body)
+	}
+}
+
+func TestSettingsTaxConfig(t *testing.T) {
+	settings := map[string]map[string]interface{}{
+		"general": {"woocommerce_currency": "USD", "woocommerce_calc_taxes": "no"},
+		"tax": {
+			"woocommerce_prices_include_tax":    "no",
+			"woocommerce_tax_based_on":          "shipping",
+			"woocommerce_tax_round_at_subtotal": "no",
+		},
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/wp-json/wc/v3/settings/"), "/")
+		group := settings[parts[0]]
+		if r.Method == http.MethodPost && len(parts) == 2 && parts[1] == "batch" {
+			var req BatchRequest[SettingOption]
+			json.NewDecoder(r.Body).Decode(&req)
+			for _, option := range req.Update {
+				if _, ok := group[option.ID]; !ok {
+					t.Errorf("unknown option %s in group %s", option.ID, parts[0])
+				}
+				group[option.ID] = option.Value
+			}
+			json.NewEncoder(w).Encode(BatchResponse[SettingOption]{Update: req.Update})
+			return
+		}
+		options := []SettingOption{}
+		for id, value := range group {
+			options = append(options, SettingOption{ID: id, Value: value})
+		}
+		json.NewEncoder(w).Encode(options)
+	})
+	ctx := context.Background()
+	config, err := client.Settings.TaxConfig(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *config != (TaxConfig{TaxBasedOn: "shipping"}) {
+		t.Errorf("config = %+v", config)
+	}
+
+	want := TaxConfig{CalcTaxes: true, PricesIncludeTax: true, TaxBasedOn: "billing", RoundAtSubtotal: true}
+	if err := client.Settings.UpdateTaxConfig(ctx, &want); err != nil {
+		t.Fatal(err)
+	}
+	if settings["general"]["woocommerce_calc_taxes"] != "yes" || settings["tax"]["woocommerce_tax_based_on"] != "billing" {
+		t.Errorf("settings = %v", settings)
+	}
+	if config, err = client.Settings.TaxConfig(ctx); err != nil || *config != want {
+		t.Errorf("round trip = %+v, %v", config, err)
+	}
+}
@@ -0,0 +1,75 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// ShippingMethodsService reads the shipping methods available at
+// /shipping_methods.
+type ShippingMethodsService service
+
+// ShippingClassesService manages /products/shipping_classes.
+type ShippingClassesService service
+
+type ShippingMethod struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type ShippingClass struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	Count       int    `json:"count,omitempty"`
+}
+
+func (s *ShippingMethodsService) List(ctx context.Context) ([]ShippingMethod, error) {
+	var methods []ShippingMethod
+	_, err := s.client.list(ctx, "shipping_methods", nil, &methods)
+	return methods, err
+}
+
+func (s *ShippingMethodsService) Get(ctx context.Context, id string) (*ShippingMethod, error) {
+	method := new(ShippingMethod)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("shipping_methods", id), nil, nil, method)
+	return method, err
+}
+
+func (s *ShippingClassesService) List(ctx context.Context, params *ListParams) ([]ShippingClass, *Response, error) {
+	var classes []ShippingClass
+	resp, err := s.client.list(ctx, "products/shipping_classes", params, &classes)
+	return classes, resp, err
+}
+
+func (s *ShippingClassesService) Get(ctx context.Context, id int) (*ShippingClass, error) {
+	class := new(ShippingClass)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "shipping_classes", id), nil, nil, class)
+	return class, err
+}
+
+func (s *ShippingClassesService) Create(ctx context.Context, class *ShippingClass) (*ShippingClass, error) {
+	created := new(ShippingClass)
+	_, err := s.client.call(ctx, http.MethodPost, "products/shipping_classes", nil, class, created)
+	return created, err
+}
+
+func (s *ShippingClassesService) Update(ctx context.Context, id int, class *ShippingClass) (*ShippingClass, error) {
+	updated := new(ShippingClass)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "shipping_classes", id), nil, class, updated)
+	return updated, err
+}
+
+// Delete deletes a shipping class. Terms cannot be trashed, so the request
+// is always forced.
+func (s *ShippingClassesService) Delete(ctx context.Context, id int) (*ShippingClass, error) {
+	deleted := new(ShippingClass)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "shipping_classes", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *ShippingClassesService) Batch(ctx context.Context, req *BatchRequest[ShippingClass]) (*BatchResponse[ShippingClass], error) {
+	return batch(ctx, s.client, "products/shipping_classes/batch", req)
+}
@@ -0,0 +1,72 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestShippingMethods(t *testing.T) {
+	var path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if path == "/wp-json/wc/v3/shipping_methods" {
+			w.Write([]byte(`[{"id":"flat_rate","title":"Flat rate","description":"Lets you charge a fixed rate for shipping."},{"id":"free_shipping","title":"Free shipping","description":""}]`))
+			return
+		}
+		w.Write([]byte(`{"id":"flat_rate","title":"Flat rate","description":"Lets you charge a fixed rate for shipping."}`))
+	})
+	ctx := context.Background()
+	methods, err := client.ShippingMethods.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 2 || methods[1].ID != "free_shipping" {
+		t.Errorf("methods = %+v", methods)
+	}
+	method, err := client.ShippingMethods.Get(ctx, "flat_rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method.Title != "Flat rate" || path != "/wp-json/wc/v3/shipping_methods/flat_rate" {
+		t.Errorf("get %s = %+v", path, method)
+	}
+}
+
+func TestShippingClasses(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet && path == "/wp-json/wc/v3/products/shipping_classes" {
+			w.Write([]byte(`[{"id":32,"name":"Priority","slug":"priority","count":4}]`))
+			return
+		}
+		w.Write([]byte(`{"id":32,"name":"Priority","slug":"priority","count":4}`))
+	})
+	ctx := context.Background()
+	classes, _, err := client.ShippingClasses.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 1 || classes[0].Slug != "priority" || classes[0].Count != 4 {
+		t.Errorf("classes = %+v", classes)
+	}
+	if _, err := client.ShippingClasses.Create(ctx, &ShippingClass{Name: "Priority"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/products/shipping_classes" {
+		t.Errorf("create sent %s %s", method, path)
+	}
+	if _, err := client.ShippingClasses.Update(ctx, 32, &ShippingClass{Description: "Express"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/products/shipping_classes/32" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+	if _, err := client.ShippingClasses.Delete(ctx, 32); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete {
+		t.Errorf("delete sent %s", method)
+	}
+}
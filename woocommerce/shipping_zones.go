@@ -0,0 +1,135 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// ShippingZonesService manages /shipping/zones and the locations and
+// shipping methods of each zone.
+type ShippingZonesService service
+
+type ShippingZone struct {
+	ID    int    `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Order int    `json:"order,omitempty"`
+}
+
+// ShippingZoneLocation is a postcode, state, country or continent that
+// belongs to a zone, identified by Code and Type.
+type ShippingZoneLocation struct {
+	Code string `json:"code"`
+	Type string `json:"type"`
+}
+
+type ShippingZoneMethod struct {
+	InstanceID        int                      `json:"instance_id,omitempty"`
+	Title             string                   `json:"title,omitempty"`
+	Order             int                      `json:"order,omitempty"`
+	Enabled           bool                     `json:"enabled,omitempty"`
+	MethodID          string                   `json:"method_id,omitempty"`
+	MethodTitle       string                   `json:"method_title,omitempty"`
+	MethodDescription string                   `json:"method_description,omitempty"`
+	Settings          map[string]MethodSetting `json:"settings,omitempty"`
+}
+
+// MethodSetting is a setting of a shipping method or payment gateway.
+type MethodSetting struct {
+	ID          string            `json:"id"`
+	Label       string            `json:"label,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Value       string            `json:"value"`
+	Default     string            `json:"default,omitempty"`
+	Tip         string            `json:"tip,omitempty"`
+	Placeholder string            `json:"placeholder,omitempty"`
+	Options     map[string]string `json:"options,omitempty"`
+}
+
+// ShippingZoneMethodUpdate changes a zone's shipping method. Nil fields
+// are left unchanged; Settings maps setting IDs to their new values.
+type ShippingZoneMethodUpdate struct {
+	Order    *int              `json:"order,omitempty"`
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+func (s *ShippingZonesService) List(ctx context.Context) ([]ShippingZone, error) {
+	var zones []ShippingZone
+	_, err := s.client.list(ctx, "shipping/zones", nil, &zones)
+	return zones, err
+}
+
+func (s *ShippingZonesService) Get(ctx context.Context, id int) (*ShippingZone, error) {
+	zone := new(ShippingZone)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("shipping", "zones", id), nil, nil, zone)
+	return zone, err
+}
+
+func (s *ShippingZonesService) Create(ctx context.Context, zone *ShippingZone) (*ShippingZone, error) {
+	created := new(ShippingZone)
+	_, err := s.client.call(ctx, http.MethodPost, "shipping/zones", nil, zone, created)
+	return created, err
+}
+
+func (s *ShippingZonesService) Update(ctx context.Context, id int, zone *ShippingZone) (*ShippingZone, error) {
+	updated := new(ShippingZone)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("shipping", "zones", id), nil, zone, updated)
+	return updated, err
+}
+
+// Delete deletes a zone. Zones cannot be trashed, so the request is always
+// forced.
+func (s *ShippingZonesService) Delete(ctx context.Context, id int) (*ShippingZone, error) {
+	deleted := new(ShippingZone)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("shipping", "zones", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *ShippingZonesService) Locations(ctx context.Context, zoneID int) ([]ShippingZoneLocation, error) {
+	var locations []ShippingZoneLocation
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("shipping", "zones", zoneID, "locations"), nil, nil, &locations)
+	return locations, err
+}
+
+// UpdateLocations replaces all of a zone's locations.
+func (s *ShippingZonesService) UpdateLocations(ctx context.Context, zoneID int, locations []ShippingZoneLocation) ([]ShippingZoneLocation, error) {
+	if locations == nil {
+		locations = []ShippingZoneLocation{}
+	}
+	var updated []ShippingZoneLocation
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("shipping", "zones", zoneID, "locations"), nil, locations, &updated)
+	return updated, err
+}
+
+func (s *ShippingZonesService) Methods(ctx context.Context, zoneID int) ([]ShippingZoneMethod, error) {
+	var methods []ShippingZoneMethod
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("shipping", "zones", zoneID, "methods"), nil, nil, &methods)
+	return methods, err
+}
+
+func (s *ShippingZonesService) GetMethod(ctx context.Context, zoneID, instanceID int) (*ShippingZoneMethod, error) {
+	method := new(ShippingZoneMethod)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("shipping", "zones", zoneID, "methods", instanceID), nil, nil, method)
+	return method, err
+}
+
+// AddMethod adds a shipping method, such as "flat_rate", to a zone.
+func (s *ShippingZonesService) AddMethod(ctx context.Context, zoneID int, methodID string) (*ShippingZoneMethod, error) {
+	created := new(ShippingZoneMethod)
+	in := map[string]string{"method_id": methodID}
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("shipping", "zones", zoneID, "methods"), nil, in, created)
+	return created, err
+}
+
+func (s *ShippingZonesService) UpdateMethod(ctx context.Context, zoneID, instanceID int, update *ShippingZoneMethodUpdate) (*ShippingZoneMethod, error) {
+	updated := new(ShippingZoneMethod)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("shipping", "zones", zoneID, "methods", instanceID), nil, update, updated)
+	return updated, err
+}
+
+func (s *ShippingZonesService) DeleteMethod(ctx context.Context, zoneID, instanceID int) (*ShippingZoneMethod, error) {
+	deleted := new(ShippingZoneMethod)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("shipping", "zones", zoneID, "methods", instanceID), forceParam(true), nil, deleted)
+	return deleted, err
+}
@@ -0,0 +1,129 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const zoneMethodJSON = `{
+	"instance_id": 26,
+	"title": "Flat rate",
+	"order": 1,
+	"enabled": true,
+	"method_id": "flat_rate",
+	"method_title": "Flat rate",
+	"settings": {
+		"cost": {"id": "cost", "label": "Cost", "type": "text", "value": "10.00", "default": ""},
+		"tax_status": {"id": "tax_status", "label": "Tax status", "type": "select", "value": "taxable", "options": {"taxable": "Taxable", "none": "None"}}
+	}
+}`
+
+func TestShippingZoneLocations(t *testing.T) {
+	var method string
+	var body []interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		if r.URL.Path != "/wp-json/wc/v3/shipping/zones/5/locations" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.Write([]byte(`[{"code":"BR","type":"country"},{"code":"US:CA","type":"state"}]`))
+	})
+	ctx := context.Background()
+	locations, err := client.ShippingZones.Locations(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locations) != 2 || locations[1].Code != "US:CA" || locations[1].Type != "state" {
+		t.Errorf("locations = %+v", locations)
+	}
+	if _, err := client.ShippingZones.UpdateLocations(ctx, 5, []ShippingZoneLocation{{Code: "BR", Type: "country"}}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || len(body) != 1 {
+		t.Errorf("update sent %s %v", method, body)
+	}
+}
+
+func TestShippingZoneMethods(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(data, &body)
+		if r.Method == http.MethodGet && path == "/wp-json/wc/v3/shipping/zones/5/methods" {
+			w.Write([]byte("[" + zoneMethodJSON + "]"))
+			return
+		}
+		w.Write([]byte(zoneMethodJSON))
+	})
+	ctx := context.Background()
+	methods, err := client.ShippingZones.Methods(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 1 || methods[0].Settings["cost"].Value != "10.00" || methods[0].Settings["tax_status"].Options["none"] != "None" {
+		t.Errorf("methods = %+v", methods)
+	}
+	if _, err := client.ShippingZones.AddMethod(ctx, 5, "flat_rate"); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["method_id"] != "flat_rate" {
+		t.Errorf("add sent %s %v", method, body)
+	}
+	enabled := false
+	if _, err := client.ShippingZones.UpdateMethod(ctx, 5, 26, &ShippingZoneMethodUpdate{Enabled: &enabled, Settings: map[string]string{"cost": "20.00"}}); err != nil {
+		t.Fatal(err)
+	}
+	settings, _ := body["settings"].(map[string]interface{})
+	if method != http.MethodPut || path != "/wp-json/wc/v3/shipping/zones/5/methods/26" || body["enabled"] != false || settings["cost"] != "20.00" {
+		t.Errorf("update sent %s %s %v", method, path, body)
+	}
+	if _, ok := body["order"]; ok {
+		t.Errorf("unset order was sent: %v", body)
+	}
+	if _, err := client.ShippingZones.DeleteMethod(ctx, 5, 26); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete {
+		t.Errorf("delete sent %s", method)
+	}
+}
+
+func TestShippingZonesCRUD(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet && path == "/wp-json/wc/v3/shipping/zones" {
+			w.Write([]byte(`[{"id":0,"name":"Locations not covered by your other zones","order":0},{"id":5,"name":"Brazil","order":1}]`))
+			return
+		}
+		w.Write([]byte(`{"id":5,"name":"Brazil","order":1}`))
+	})
+	ctx := context.Background()
+	zones, err := client.ShippingZones.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zones) != 2 || zones[1].Name != "Brazil" {
+		t.Errorf("zones = %+v", zones)
+	}
+	if _, err := client.ShippingZones.Create(ctx, &ShippingZone{Name: "Brazil"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/shipping/zones" {
+		t.Errorf("create sent %s %s", method, path)
+	}
+	if _, err := client.ShippingZones.Delete(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || path != "/wp-json/wc/v3/shipping/zones/5" {
+		t.Errorf("delete sent %s %s", method, path)
+	}
+}
@@ -0,0 +1,21 @@
+package woocommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// SignHMAC returns the base64 encoded HMAC-SHA256 of data keyed by secret.
+// This is the signature WooCommerce uses for OAuth requests and webhooks.
+func SignHMAC(secret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyHMAC reports whether signature is the SignHMAC of data, comparing
+// in constant time.
+func VerifyHMAC(secret string, data []byte, signature string) bool {
+	return hmac.Equal([]byte(SignHMAC(secret, data)), []byte(signature))
+}
@@ -0,0 +1,33 @@
+package woocommerce
+
+import "testing"
+
+func TestSignHMAC(t *testing.T) {
+	cases := []struct {
+		secret, data, want string
+	}{
+		{"key", "The quick brown fox jumps over the lazy dog", "97yD9DBThCSxMpjmqm+xQ+9NWaFJRhdZl0edvC0aPNg="},
+		{"", "", "thNnmggU2ex3L5XXeMNfxf8Wl8STcVZTxscSFEKSxa0="},
+	}
+	for _, tc := range cases {
+		if got := SignHMAC(tc.secret, []byte(tc.data)); got != tc.want {
+			t.Errorf("SignHMAC(%q, %q) = %q, want %q", tc.secret, tc.data, got, tc.want)
+		}
+		if !VerifyHMAC(tc.secret, []byte(tc.data), tc.want) {
+			t.Errorf("VerifyHMAC(%q, %q) = false, want true", tc.secret, tc.data)
+		}
+	}
+}
+
+func TestVerifyHMACMismatch(t *testing.T) {
+	sig := SignHMAC("key", []byte("payload"))
+	if VerifyHMAC("other", []byte("payload"), sig) {
+		t.Error("signature verified with wrong secret")
+	}
+	if VerifyHMAC("key", []byte("tampered"), sig) {
+		t.Error("signature verified for tampered payload")
+	}
+	if VerifyHMAC("key", []byte("payload"), "") {
+		t.Error("empty signature verified")
+	}
+}
@@ -0,0 +1,159 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultBroadcastConcurrency is the number of stores Broadcast works on at
+// once when StoreManager.Concurrency is not set.
+const DefaultBroadcastConcurrency = 8
+
+// StoreConfig holds the credentials and settings of one store.
+type StoreConfig struct {
+	URL            string
+	ConsumerKey    string
+	ConsumerSecret string
+	// Option replaces the manager's default option for this store.
+	Option *Option
+	// RateLimit and RateBurst, if set, override the option's rate limit.
+	// Every store has its own limiter.
+	RateLimit float64
+	RateBurst int
+}
+
+// StoreManager holds the configuration of many stores and creates a Client
+// for each on first use. It is safe for concurrent use.
+//
+// The default option is copied for every store, so pointer fields such as
+// Cache and IdempotencyLedger are shared. A shared Cache is safe, as its
+// keys include the store's host; set IdempotencyLedger per store through
+// StoreConfig.Option, as idempotency keys do not include the store.
+type StoreManager struct {
+	// Concurrency limits how many stores Broadcast works on at once.
+	Concurrency int
+
+	option  *Option
+	mu      sync.Mutex
+	configs map[string]StoreConfig
+	clients map[string]*Client
+}
+
+// StoreError reports a failure on one store during Broadcast.
+type StoreError struct {
+	StoreID string
+	Err     error
+}
+
+func (e *StoreError) Error() string {
+	return fmt.Sprintf("Store %s: %v", e.StoreID, e.Err)
+}
+
+func (e *StoreError) Unwrap() error {
+	return e.Err
+}
+
+// NewStoreManager returns an empty manager whose stores use option unless
+// they have their own. option may be nil.
+func NewStoreManager(option *Option) *StoreManager {
+	return &StoreManager{
+		option:  option,
+		configs: make(map[string]StoreConfig),
+		clients: make(map[string]*Client),
+	}
+}
+
+// Add registers the store id, replacing any store with the same id.
+func (m *StoreManager) Add(id string, config StoreConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.configs[id] = config
+	delete(m.clients, id)
+}
+
+// Remove forgets the store id and its client.
+func (m *StoreManager) Remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.configs, id)
+	delete(m.clients, id)
+}
+
+// IDs returns the ids of all stores in sorted order.
+func (m *StoreManager) IDs() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.configs))
+	for id := range m.configs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Client returns the client of the store id, creating it if needed.
+func (m *StoreManager) Client(id string) (*Client, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.clients[id]; ok {
+		return c, nil
+	}
+	config, ok := m.configs[id]
+	if !ok {
+		return nil, fmt.Errorf("Store is not registered: %s", id)
+	}
+	option := new(Option)
+	if config.Option != nil {
+		*option = *config.Option
+	} else if m.option != nil {
+		*option = *m.option
+	}
+	if config.RateLimit > 0 {
+		option.RateLimit, option.RateBurst = config.RateLimit, config.RateBurst
+	}
+	c, err := NewClient(config.URL, config.ConsumerKey, config.ConsumerSecret, option)
+	if err != nil {
+		return nil, fmt.Errorf("Store %s: %v", id, err)
+	}
+	m.clients[id] = c
+	return c, nil
+}
+
+// Broadcast calls fn for every store, working on up to Concurrency stores
+// at once, and waits for all of them. Failures are returned joined, as a
+// *StoreError per store in id order.
+func (m *StoreManager) Broadcast(ctx context.Context, fn func(ctx context.Context, id string, client *Client) error) error {
+	ids := m.IDs()
+	limit := m.Concurrency
+	if limit <= 0 {
+		limit = DefaultBroadcastConcurrency
+	}
+	sem := make(chan struct{}, limit)
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = &StoreError{StoreID: id, Err: ctx.Err()}
+				return
+			}
+			defer func() { <-sem }()
+			client, err := m.Client(id)
+			if err == nil {
+				err = fn(ctx, id, client)
+			}
+			if err != nil {
+				errs[i] = &StoreError{StoreID: id, Err: err}
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,59 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestStoreManager(t *testing.T) {
+	manager := NewStoreManager(&Option{RateLimit: 100})
+	for _, id := range []string{"eu", "us"} {
+		srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"id":1,"currency":"` + map[string]string{"eu": "EUR", "us": "USD"}[id] + `"}]`))
+		})
+		manager.Add(id, StoreConfig{URL: srv.URL, ConsumerKey: "ck_" + id, ConsumerSecret: "cs", RateLimit: 5, RateBurst: 1})
+	}
+	manager.Add("down", StoreConfig{URL: "http://127.0.0.1:1", ConsumerKey: "ck", ConsumerSecret: "cs"})
+
+	eu, err := manager.Client("eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := manager.Client("eu"); again != eu {
+		t.Error("client not reused")
+	}
+	if eu.option.RateLimit != 5 || eu.ck != "ck_eu" {
+		t.Errorf("eu option = %+v", eu.option)
+	}
+	if _, err := manager.Client("asia"); err == nil {
+		t.Error("expected error for unknown store")
+	}
+
+	var mu sync.Mutex
+	currencies := map[string]string{}
+	err = manager.Broadcast(context.Background(), func(ctx context.Context, id string, client *Client) error {
+		orders, _, err := client.Orders.List(ctx, nil)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		currencies[id] = orders[0].Currency
+		mu.Unlock()
+		return nil
+	})
+	var storeErr *StoreError
+	if !errors.As(err, &storeErr) || storeErr.StoreID != "down" {
+		t.Errorf("err = %v", err)
+	}
+	if currencies["eu"] != "EUR" || currencies["us"] != "USD" || len(currencies) != 2 {
+		t.Errorf("currencies = %v", currencies)
+	}
+
+	manager.Remove("down")
+	if ids := manager.IDs(); len(ids) != 2 || ids[0] != "eu" {
+		t.Errorf("ids = %v", ids)
+	}
+}
@@ -0,0 +1,59 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Stream walks a list endpoint like ListAll, but decodes the items of each
+// page one at a time as they are read from the response and calls fn with
+// each. Only the current item is held in memory rather than whole pages,
+// which matters for catalogs with a very large number of items.
+func (c *Client) Stream(ctx context.Context, endpoint string, params url.Values, fn func(item json.RawMessage) error) error {
+	return stream(ctx, c, endpoint, params, func(item *json.RawMessage) error {
+		return fn(*item)
+	})
+}
+
+func stream[T any](ctx context.Context, c *Client, endpoint string, params url.Values, fn func(*T) error) error {
+	return c.walk(ctx, endpoint, params, func(resp *Response) (int, error) {
+		return decodeStream(resp, fn)
+	})
+}
+
+func streamList[T any](ctx context.Context, c *Client, endpoint string, params *ListParams, fn func(*T) error) error {
+	query, err := params.Values()
+	if err != nil {
+		return err
+	}
+	return stream(ctx, c, endpoint, query, fn)
+}
+
+// decodeStream decodes the JSON array read from r item by item, calling fn
+// with each, and returns the number of items decoded.
+func decodeStream[T any](r io.Reader, fn func(*T) error) (int, error) {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("Response is not a JSON array: %v", tok)
+	}
+	n := 0
+	for dec.More() {
+		item := new(T)
+		if err := dec.Decode(item); err != nil {
+			return n, err
+		}
+		n++
+		if err := fn(item); err != nil {
+			return n, err
+		}
+	}
+	_, err = dec.Token()
+	return n, err
+}
@@ -0,0 +1,54 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	var pages []string
+	client := newPagedServer(t, 7, &pages)
+	var ids []int
+	err := client.Products.Stream(context.Background(), NewListParams().PerPage(3), func(p *Product) error {
+		ids = append(ids, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5 6 7]" || fmt.Sprint(pages) != "[1 2 3]" {
+		t.Errorf("ids = %v, pages = %v", ids, pages)
+	}
+
+	pages = nil
+	stop := errors.New("stop")
+	var raw []string
+	err = client.Stream(context.Background(), "products", url.Values{"per_page": {"3"}}, func(item json.RawMessage) error {
+		raw = append(raw, string(item))
+		if len(raw) == 4 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || fmt.Sprint(pages) != "[1 2]" || raw[3] != `{"id":4}` {
+		t.Errorf("err = %v, pages = %v, items = %v", err, pages, raw)
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	n, err := decodeStream(strings.NewReader(`[{"id":1},{"id":2}]`), func(o *Order) error { return nil })
+	if err != nil || n != 2 {
+		t.Errorf("n = %d, err = %v", n, err)
+	}
+	if _, err := decodeStream(strings.NewReader(`{"id":1}`), func(o *Order) error { return nil }); err == nil {
+		t.Error("expected error for an object response")
+	}
+	if n, err := decodeStream(strings.NewReader(`[{"id":1},{"id":`), func(o *Order) error { return nil }); err == nil || n != 1 {
+		t.Errorf("truncated body: n = %d, err = %v", n, err)
+	}
+}
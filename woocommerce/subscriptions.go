@@ -0,0 +1,128 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// SubscriptionsService manages /subscriptions, added by the WooCommerce
+// Subscriptions extension. Subscriptions are orders with a billing
+// schedule, so they share the order fields. The extension must be active
+// on the store; without it every request fails with a 404 APIError whose
+// Code is "rest_no_route".
+type SubscriptionsService service
+
+// Subscription status values. A subscription is suspended by putting it on
+// hold, and reactivated by making it active again.
+const (
+	SubscriptionStatusPending       = "pending"
+	SubscriptionStatusActive        = "active"
+	SubscriptionStatusOnHold        = "on-hold"
+	SubscriptionStatusCancelled     = "cancelled"
+	SubscriptionStatusPendingCancel = "pending-cancel"
+	SubscriptionStatusExpired       = "expired"
+)
+
+type Subscription struct {
+	Order
+	// BillingInterval is sent as a number or a numeric string depending on
+	// the extension version.
+	BillingInterval    json.Number `json:"billing_interval,omitempty"`
+	BillingPeriod      string      `json:"billing_period,omitempty"`
+	StartDateGMT       WCTime      `json:"start_date_gmt,omitzero"`
+	TrialEndDateGMT    WCTime      `json:"trial_end_date_gmt,omitzero"`
+	NextPaymentDateGMT WCTime      `json:"next_payment_date_gmt,omitzero"`
+	LastPaymentDateGMT WCTime      `json:"last_payment_date_gmt,omitzero"`
+	CancelledDateGMT   WCTime      `json:"cancelled_date_gmt,omitzero"`
+	EndDateGMT         WCTime      `json:"end_date_gmt,omitzero"`
+	ResubscribedFrom   string      `json:"resubscribed_from,omitempty"`
+	ResubscribedTo     string      `json:"resubscribed_subscription,omitempty"`
+}
+
+func (s *SubscriptionsService) List(ctx context.Context, params *ListParams) ([]Subscription, *Response, error) {
+	var subscriptions []Subscription
+	resp, err := s.client.list(ctx, "subscriptions", params, &subscriptions)
+	return subscriptions, resp, err
+}
+
+// ListAll calls fn with every page of subscriptions matching params.
+func (s *SubscriptionsService) ListAll(ctx context.Context, params *ListParams, fn func([]Subscription) error) error {
+	return listAll(ctx, s.client, "subscriptions", params, fn)
+}
+
+func (s *SubscriptionsService) Get(ctx context.Context, id int) (*Subscription, error) {
+	subscription := new(Subscription)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("subscriptions", id), nil, nil, subscription)
+	return subscription, err
+}
+
+func (s *SubscriptionsService) Create(ctx context.Context, subscription *Subscription) (*Subscription, error) {
+	created := new(Subscription)
+	_, err := s.client.call(ctx, http.MethodPost, "subscriptions", nil, subscription, created)
+	return created, err
+}
+
+func (s *SubscriptionsService) Update(ctx context.Context, id int, subscription *Subscription) (*Subscription, error) {
+	updated := new(Subscription)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("subscriptions", id), nil, subscription, updated)
+	return updated, err
+}
+
+func (s *SubscriptionsService) Delete(ctx context.Context, id int, force bool) (*Subscription, error) {
+	deleted := new(Subscription)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("subscriptions", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+// SetStatus moves a subscription to status, one of the
+// SubscriptionStatus values.
+func (s *SubscriptionsService) SetStatus(ctx context.Context, id int, status string) (*Subscription, error) {
+	return s.Update(ctx, id, &Subscription{Order: Order{Status: OrderStatus(status)}})
+}
+
+// Suspend puts a subscription on hold, stopping renewals until it is
+// reactivated.
+func (s *SubscriptionsService) Suspend(ctx context.Context, id int) (*Subscription, error) {
+	return s.SetStatus(ctx, id, SubscriptionStatusOnHold)
+}
+
+// Cancel cancels a subscription immediately. Set the status to
+// SubscriptionStatusPendingCancel instead to let it run to the end of the
+// paid period.
+func (s *SubscriptionsService) Cancel(ctx context.Context, id int) (*Subscription, error) {
+	return s.SetStatus(ctx, id, SubscriptionStatusCancelled)
+}
+
+// Reactivate makes a suspended subscription active again.
+func (s *SubscriptionsService) Reactivate(ctx context.Context, id int) (*Subscription, error) {
+	return s.SetStatus(ctx, id, SubscriptionStatusActive)
+}
+
+// Orders returns the parent, renewal, resubscribe and switch orders of a
+// subscription.
+func (s *SubscriptionsService) Orders(ctx context.Context, id int) ([]Order, error) {
+	var orders []Order
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("subscriptions", id, "orders"), nil, nil, &orders)
+	return orders, err
+}
+
+func (s *SubscriptionsService) Notes(ctx context.Context, id int) ([]OrderNote, error) {
+	var notes []OrderNote
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("subscriptions", id, "notes"), nil, nil, &notes)
+	return notes, err
+}
+
+func (s *SubscriptionsService) AddNote(ctx context.Context, id int, note *OrderNote) (*OrderNote, error) {
+	created := new(OrderNote)
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("subscriptions", id, "notes"), nil, note, created)
+	return created, err
+}
+
+// Statuses returns the subscription statuses of the store, keyed by slug
+// with a "wc-" prefix, e.g. "wc-active".
+func (s *SubscriptionsService) Statuses(ctx context.Context) (map[string]string, error) {
+	var statuses map[string]string
+	_, err := s.client.call(ctx, http.MethodGet, "subscriptions/statuses", nil, nil, &statuses)
+	return statuses, err
+}
@@ -0,0 +1,86 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const subscriptionJSON = `{"id":3000,"parent_id":2999,"status":"active","currency":"USD","total":"10.00","customer_id":25,"billing_period":"month","billing_interval":"1","start_date_gmt":"2024-01-05T10:00:00","next_payment_date_gmt":"2024-02-05T10:00:00","end_date_gmt":""}`
+
+func TestSubscriptions(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, body = r.Method, r.URL.Path, nil
+		json.NewDecoder(r.Body).Decode(&body)
+		switch path {
+		case "/wp-json/wc/v3/subscriptions":
+			w.Write([]byte("[" + subscriptionJSON + "]"))
+		case "/wp-json/wc/v3/subscriptions/3000/orders":
+			w.Write([]byte(`[{"id":2999,"status":"completed"},{"id":3100,"status":"processing"}]`))
+		case "/wp-json/wc/v3/subscriptions/3000/notes":
+			if r.Method == http.MethodPost {
+				w.Write([]byte(`{"id":51,"note":"Moved to annual plan"}`))
+				return
+			}
+			w.Write([]byte(`[{"id":50,"note":"Status changed from Pending to Active."}]`))
+		case "/wp-json/wc/v3/subscriptions/statuses":
+			w.Write([]byte(`{"wc-active":"Active","wc-on-hold":"On hold"}`))
+		default:
+			w.Write([]byte(subscriptionJSON))
+		}
+	})
+	ctx := context.Background()
+
+	subscriptions, _, err := client.Subscriptions.List(ctx, NewListParams().Status(SubscriptionStatusActive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := subscriptions[0]
+	if sub.ID != 3000 || sub.ParentID != 2999 || sub.Total.String() != "10.00" || sub.BillingPeriod != "month" || sub.BillingInterval != "1" {
+		t.Errorf("subscription = %+v", sub)
+	}
+	if sub.NextPaymentDateGMT.Month() != 2 || !sub.EndDateGMT.IsZero() {
+		t.Errorf("dates = %v, %v", sub.NextPaymentDateGMT, sub.EndDateGMT)
+	}
+
+	for _, tc := range []struct {
+		change func(context.Context, int) (*Subscription, error)
+		status string
+	}{
+		{client.Subscriptions.Suspend, "on-hold"},
+		{client.Subscriptions.Reactivate, "active"},
+		{client.Subscriptions.Cancel, "cancelled"},
+	} {
+		if _, err := tc.change(ctx, 3000); err != nil {
+			t.Fatal(err)
+		}
+		if method != http.MethodPut || path != "/wp-json/wc/v3/subscriptions/3000" || len(body) != 1 || body["status"] != tc.status {
+			t.Errorf("%s sent %s %s %v", tc.status, method, path, body)
+		}
+	}
+
+	orders, err := client.Subscriptions.Orders(ctx, 3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 2 || orders[1].ID != 3100 {
+		t.Errorf("orders = %+v", orders)
+	}
+	notes, err := client.Subscriptions.Notes(ctx, 3000)
+	if err != nil || len(notes) != 1 {
+		t.Errorf("notes = %+v, %v", notes, err)
+	}
+	if _, err := client.Subscriptions.AddNote(ctx, 3000, &OrderNote{Note: "Moved to annual plan"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || body["note"] != "Moved to annual plan" {
+		t.Errorf("add note sent %s %v", method, body)
+	}
+	statuses, err := client.Subscriptions.Statuses(ctx)
+	if err != nil || statuses["wc-on-hold"] != "On hold" {
+		t.Errorf("statuses = %v, %v", statuses, err)
+	}
+}
@@ -0,0 +1,133 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// SystemStatusService reads /system_status and runs the maintenance tools
+// at /system_status/tools.
+type SystemStatusService service
+
+// SystemStatus is the store's system status report. Sections that are not
+// modelled, such as database tables, can be read from the raw response.
+type SystemStatus struct {
+	Environment     SystemEnvironment `json:"environment"`
+	Database        SystemDatabase    `json:"database"`
+	ActivePlugins   []SystemPlugin    `json:"active_plugins"`
+	InactivePlugins []SystemPlugin    `json:"inactive_plugins"`
+	Theme           SystemTheme       `json:"theme"`
+	Settings        SystemSettings    `json:"settings"`
+	Security        SystemSecurity    `json:"security"`
+}
+
+type SystemEnvironment struct {
+	HomeURL              string `json:"home_url"`
+	SiteURL              string `json:"site_url"`
+	Version              string `json:"version"`
+	LogDirectory         string `json:"log_directory"`
+	LogDirectoryWritable bool   `json:"log_directory_writable"`
+	WPVersion            string `json:"wp_version"`
+	WPMultisite          bool   `json:"wp_multisite"`
+	WPMemoryLimit        int    `json:"wp_memory_limit"`
+	WPDebugMode          bool   `json:"wp_debug_mode"`
+	WPCron               bool   `json:"wp_cron"`
+	Language             string `json:"language"`
+	ServerInfo           string `json:"server_info"`
+	PHPVersion           string `json:"php_version"`
+	PHPPostMaxSize       int    `json:"php_post_max_size"`
+	PHPMaxExecutionTime  int    `json:"php_max_execution_time"`
+	PHPMaxInputVars      int    `json:"php_max_input_vars"`
+	CurlVersion          string `json:"curl_version"`
+	MaxUploadSize        int    `json:"max_upload_size"`
+	MySQLVersion         string `json:"mysql_version"`
+	DefaultTimezone      string `json:"default_timezone"`
+	GzipEnabled          bool   `json:"gzip_enabled"`
+	MbstringEnabled      bool   `json:"mbstring_enabled"`
+	RemotePostSuccessful bool   `json:"remote_post_successful"`
+	RemoteGetSuccessful  bool   `json:"remote_get_successful"`
+}
+
+type SystemDatabase struct {
+	WCDatabaseVersion string `json:"wc_database_version"`
+	DatabasePrefix    string `json:"database_prefix"`
+}
+
+type SystemPlugin struct {
+	Plugin           string `json:"plugin"`
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	VersionLatest    string `json:"version_latest"`
+	URL              string `json:"url"`
+	AuthorName       string `json:"author_name"`
+	AuthorURL        string `json:"author_url"`
+	NetworkActivated bool   `json:"network_activated"`
+}
+
+type SystemTheme struct {
+	Name                  string `json:"name"`
+	Version               string `json:"version"`
+	VersionLatest         string `json:"version_latest"`
+	AuthorURL             string `json:"author_url"`
+	IsChildTheme          bool   `json:"is_child_theme"`
+	HasWooCommerceSupport bool   `json:"has_woocommerce_support"`
+	HasWooCommerceFile    bool   `json:"has_woocommerce_file"`
+	HasOutdatedTemplates  bool   `json:"has_outdated_templates"`
+	ParentName            string `json:"parent_name"`
+	ParentVersion         string `json:"parent_version"`
+}
+
+type SystemSettings struct {
+	APIEnabled         bool   `json:"api_enabled"`
+	ForceSSL           bool   `json:"force_ssl"`
+	Currency           string `json:"currency"`
+	CurrencySymbol     string `json:"currency_symbol"`
+	CurrencyPosition   string `json:"currency_position"`
+	ThousandSeparator  string `json:"thousand_separator"`
+	DecimalSeparator   string `json:"decimal_separator"`
+	NumberOfDecimals   int    `json:"number_of_decimals"`
+	GeolocationEnabled bool   `json:"geolocation_enabled"`
+}
+
+type SystemSecurity struct {
+	SecureConnection bool `json:"secure_connection"`
+	HideErrors       bool `json:"hide_errors"`
+}
+
+// SystemStatusTool is a maintenance tool, such as "clear_transients".
+// Success and Message are set in the response to RunTool.
+type SystemStatusTool struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Action      string `json:"action,omitempty"`
+	Description string `json:"description,omitempty"`
+	Success     bool   `json:"success,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+func (s *SystemStatusService) Get(ctx context.Context) (*SystemStatus, error) {
+	status := new(SystemStatus)
+	_, err := s.client.call(ctx, http.MethodGet, "system_status", nil, nil, status)
+	return status, err
+}
+
+func (s *SystemStatusService) Tools(ctx context.Context) ([]SystemStatusTool, error) {
+	var tools []SystemStatusTool
+	_, err := s.client.call(ctx, http.MethodGet, "system_status/tools", nil, nil, &tools)
+	return tools, err
+}
+
+func (s *SystemStatusService) Tool(ctx context.Context, id string) (*SystemStatusTool, error) {
+	tool := new(SystemStatusTool)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("system_status", "tools", id), nil, nil, tool)
+	return tool, err
+}
+
+// RunTool runs a tool. The tool's own failure is reported through Success
+// and Message rather than as an error.
+func (s *SystemStatusService) RunTool(ctx context.Context, id string) (*SystemStatusTool, error) {
+	tool := new(SystemStatusTool)
+	body := map[string]bool{"confirm": true}
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("system_status", "tools", id), nil, body, tool)
+	return tool, err
+}
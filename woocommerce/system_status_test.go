@@ -0,0 +1,70 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSystemStatusGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/system_status" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"environment": {"version": "8.2.1", "wp_version": "6.4", "wp_memory_limit": 268435456, "php_version": "8.2.12", "wp_cron": true},
+			"database": {"wc_database_version": "8.2.1", "database_prefix": "wp_", "database_tables": {"woocommerce": {}}},
+			"active_plugins": [{"plugin": "woocommerce/woocommerce.php", "name": "WooCommerce", "version": "8.2.1", "network_activated": false}],
+			"inactive_plugins": [],
+			"theme": {"name": "Storefront", "version": "4.5.3", "has_woocommerce_support": true},
+			"settings": {"currency": "USD", "number_of_decimals": 2},
+			"security": {"secure_connection": true, "hide_errors": true}
+		}`))
+	})
+	status, err := client.SystemStatus.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Environment.Version != "8.2.1" || status.Environment.WPMemoryLimit != 268435456 || !status.Environment.WPCron {
+		t.Errorf("environment = %+v", status.Environment)
+	}
+	if len(status.ActivePlugins) != 1 || status.ActivePlugins[0].Name != "WooCommerce" {
+		t.Errorf("active plugins = %+v", status.ActivePlugins)
+	}
+	if !status.Theme.HasWooCommerceSupport || status.Settings.NumberOfDecimals != 2 || !status.Security.SecureConnection {
+		t.Errorf("status = %+v", status)
+	}
+}
+
+func TestSystemStatusRunTool(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"id":"clear_transients","name":"WooCommerce transients","action":"Clear transients"}]`))
+			return
+		}
+		if r.Method != http.MethodPut || r.URL.Path != "/wp-json/wc/v3/system_status/tools/clear_transients" {
+			t.Errorf("run sent %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.Write([]byte(`{"id":"clear_transients","success":true,"message":"Product transients cleared"}`))
+	})
+	ctx := context.Background()
+	tools, err := client.SystemStatus.Tools(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tools) != 1 || tools[0].Action != "Clear transients" {
+		t.Errorf("tools = %+v", tools)
+	}
+	tool, err := client.SystemStatus.RunTool(ctx, "clear_transients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tool.Success || tool.Message != "Product transients cleared" || body["confirm"] != true {
+		t.Errorf("run %v = %+v", body, tool)
+	}
+}
@@ -0,0 +1,90 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ProductTagsService manages /products/tags.
+type ProductTagsService service
+
+type ProductTag struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	Count       int    `json:"count,omitempty"`
+}
+
+func (s *ProductTagsService) List(ctx context.Context, params *ListParams) ([]ProductTag, *Response, error) {
+	var tags []ProductTag
+	resp, err := s.client.list(ctx, "products/tags", params, &tags)
+	return tags, resp, err
+}
+
+// ListAll calls fn with every page of tags matching params.
+func (s *ProductTagsService) ListAll(ctx context.Context, params *ListParams, fn func([]ProductTag) error) error {
+	return listAll(ctx, s.client, "products/tags", params, fn)
+}
+
+func (s *ProductTagsService) Get(ctx context.Context, id int) (*ProductTag, error) {
+	tag := new(ProductTag)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", "tags", id), nil, nil, tag)
+	return tag, err
+}
+
+func (s *ProductTagsService) Create(ctx context.Context, tag *ProductTag) (*ProductTag, error) {
+	created := new(ProductTag)
+	_, err := s.client.call(ctx, http.MethodPost, "products/tags", nil, tag, created)
+	return created, err
+}
+
+func (s *ProductTagsService) Update(ctx context.Context, id int, tag *ProductTag) (*ProductTag, error) {
+	updated := new(ProductTag)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", "tags", id), nil, tag, updated)
+	return updated, err
+}
+
+// Delete deletes a tag. Terms cannot be trashed, so the request is always
+// forced.
+func (s *ProductTagsService) Delete(ctx context.Context, id int) (*ProductTag, error) {
+	deleted := new(ProductTag)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", "tags", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductTagsService) Batch(ctx context.Context, req *BatchRequest[ProductTag]) (*BatchResponse[ProductTag], error) {
+	return batch(ctx, s.client, "products/tags/batch", req)
+}
+
+// Ensure returns the IDs of the tags with the given names, in the same
+// order, creating any that do not exist yet. Names are matched case
+// insensitively.
+func (s *ProductTagsService) Ensure(ctx context.Context, names ...string) ([]int, error) {
+	existing := make(map[string]int)
+	err := s.ListAll(ctx, nil, func(tags []ProductTag) error {
+		for _, tag := range tags {
+			existing[strings.ToLower(tag.Name)] = tag.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(names))
+	for i, name := range names {
+		key := strings.ToLower(name)
+		id, ok := existing[key]
+		if !ok {
+			tag, err := s.Create(ctx, &ProductTag{Name: name})
+			if err != nil {
+				return nil, err
+			}
+			id = tag.ID
+			existing[key] = id
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,75 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestProductTagsEnsure(t *testing.T) {
+	var created []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`[{"id":34,"name":"Leather Shoes","slug":"leather-shoes"},{"id":35,"name":"Sale","slug":"sale"}]`))
+		case http.MethodPost:
+			var tag ProductTag
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &tag)
+			created = append(created, tag.Name)
+			fmt.Fprintf(w, `{"id":%d,"name":%q}`, 100+len(created), tag.Name)
+		}
+	})
+	ids, err := client.ProductTags.Ensure(context.Background(), "sale", "New", "Leather Shoes", "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[35 101 34 101]" {
+		t.Errorf("ids = %v, want [35 101 34 101]", ids)
+	}
+	if fmt.Sprint(created) != "[New]" {
+		t.Errorf("created = %v, want [New]", created)
+	}
+}
+
+func TestProductTagsCRUD(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.URL.Path == "/wp-json/wc/v3/products/tags/batch" {
+			w.Write([]byte(`{"create":[{"id":36,"name":"Round toe"}],"delete":[{"id":34}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":34,"name":"Leather Shoes","slug":"leather-shoes","count":3}`))
+	})
+	ctx := context.Background()
+	tag, err := client.ProductTags.Get(ctx, 34)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Slug != "leather-shoes" || path != "/wp-json/wc/v3/products/tags/34" {
+		t.Errorf("get %s = %+v", path, tag)
+	}
+	if _, err := client.ProductTags.Update(ctx, 34, &ProductTag{Description: "Genuine leather."}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut {
+		t.Errorf("update sent %s", method)
+	}
+	if _, err := client.ProductTags.Delete(ctx, 34); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete {
+		t.Errorf("delete sent %s", method)
+	}
+	resp, err := client.ProductTags.Batch(ctx, &BatchRequest[ProductTag]{Create: []ProductTag{{Name: "Round toe"}}, Delete: []int{34}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Create[0].ID != 36 || resp.Delete[0].ID != 34 {
+		t.Errorf("batch = %+v", resp)
+	}
+}
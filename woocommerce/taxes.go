@@ -0,0 +1,182 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// TaxRatesService manages /taxes.
+type TaxRatesService service
+
+// TaxClassesService manages /taxes/classes.
+type TaxClassesService service
+
+// TaxRate is a tax rate. Compound and Shipping are always sent, since
+// WooCommerce defaults Shipping to true.
+type TaxRate struct {
+	ID        int      `json:"id,omitempty"`
+	Country   string   `json:"country,omitempty"`
+	State     string   `json:"state,omitempty"`
+	Postcode  string   `json:"postcode,omitempty"`
+	City      string   `json:"city,omitempty"`
+	Postcodes []string `json:"postcodes,omitempty"`
+	Cities    []string `json:"cities,omitempty"`
+	Rate      string   `json:"rate,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Priority  int      `json:"priority,omitempty"`
+	Compound  bool     `json:"compound"`
+	Shipping  bool     `json:"shipping"`
+	Order     int      `json:"order,omitempty"`
+	Class     string   `json:"class,omitempty"`
+}
+
+type TaxClass struct {
+	Slug string `json:"slug,omitempty"`
+	Name string `json:"name"`
+}
+
+func (s *TaxRatesService) List(ctx context.Context, params *ListParams) ([]TaxRate, *Response, error) {
+	var rates []TaxRate
+	resp, err := s.client.list(ctx, "taxes", params, &rates)
+	return rates, resp, err
+}
+
+// ListByClass returns every rate of a tax class, such as "standard" or
+// "reduced-rate".
+func (s *TaxRatesService) ListByClass(ctx context.Context, class string) ([]TaxRate, error) {
+	var rates []TaxRate
+	err := listAll(ctx, s.client, "taxes", NewListParams().Set("class", class), func(page []TaxRate) error {
+		rates = append(rates, page...)
+		return nil
+	})
+	return rates, err
+}
+
+func (s *TaxRatesService) Get(ctx context.Context, id int) (*TaxRate, error) {
+	rate := new(TaxRate)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("taxes", id), nil, nil, rate)
+	return rate, err
+}
+
+func (s *TaxRatesService) Create(ctx context.Context, rate *TaxRate) (*TaxRate, error) {
+	created := new(TaxRate)
+	_, err := s.client.call(ctx, http.MethodPost, "taxes", nil, rate, created)
+	return created, err
+}
+
+func (s *TaxRatesService) Update(ctx context.Context, id int, rate *TaxRate) (*TaxRate, error) {
+	updated := new(TaxRate)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("taxes", id), nil, rate, updated)
+	return updated, err
+}
+
+// Delete deletes a tax rate. Rates cannot be trashed, so the request is
+// always forced.
+func (s *TaxRatesService) Delete(ctx context.Context, id int) (*TaxRate, error) {
+	deleted := new(TaxRate)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("taxes", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *TaxRatesService) Batch(ctx context.Context, req *BatchRequest[TaxRate]) (*BatchResponse[TaxRate], error) {
+	return batch(ctx, s.client, "taxes/batch", req)
+}
+
+// Load creates rates in batches of up to 100 and returns the created
+// rates in order. Rates that failed are left at their zero value and the
+// first failure is returned as a *BatchError.
+func (s *TaxRatesService) Load(ctx context.Context, rates []TaxRate) ([]TaxRate, error) {
+	resp, err := s.Batch(ctx, &BatchRequest[TaxRate]{Create: rates})
+	if err != nil {
+		return resp.Create, err
+	}
+	return resp.Create, resp.Err()
+}
+
+// ParseTaxRatesCSV reads a tax rate table in the CSV format used by the
+// WooCommerce tax settings import and export: a header row followed by
+// country code, state code, postcodes, cities, rate, name, priority,
+// compound, shipping and tax class columns. Multiple postcodes or cities
+// are separated by ";". class is used for rows without a tax class column.
+func ParseTaxRatesCSV(r io.Reader, class string) ([]TaxRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var rates []TaxRate
+	for i, record := range records {
+		if i == 0 {
+			continue
+		}
+		if len(record) < 9 {
+			return nil, fmt.Errorf("Tax rate CSV line %d: expected at least 9 columns, got %d", i+1, len(record))
+		}
+		rate := TaxRate{
+			Country:   csvWildcard(record[0]),
+			State:     csvWildcard(record[1]),
+			Postcodes: csvList(record[2]),
+			Cities:    csvList(record[3]),
+			Rate:      strings.TrimSpace(record[4]),
+			Name:      strings.TrimSpace(record[5]),
+			Compound:  strings.TrimSpace(record[7]) == "1",
+			Shipping:  strings.TrimSpace(record[8]) == "1",
+			Class:     class,
+		}
+		if rate.Priority, err = strconv.Atoi(strings.TrimSpace(record[6])); err != nil {
+			return nil, fmt.Errorf("Tax rate CSV line %d: invalid priority %q", i+1, record[6])
+		}
+		if len(record) > 9 && strings.TrimSpace(record[9]) != "" {
+			rate.Class = strings.TrimSpace(record[9])
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+func csvWildcard(field string) string {
+	field = strings.TrimSpace(field)
+	if field == "*" {
+		return ""
+	}
+	return field
+}
+
+func csvList(field string) []string {
+	field = csvWildcard(field)
+	if field == "" {
+		return nil
+	}
+	var list []string
+	for _, v := range strings.Split(field, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func (s *TaxClassesService) List(ctx context.Context) ([]TaxClass, error) {
+	var classes []TaxClass
+	_, err := s.client.list(ctx, "taxes/classes", nil, &classes)
+	return classes, err
+}
+
+func (s *TaxClassesService) Create(ctx context.Context, class *TaxClass) (*TaxClass, error) {
+	created := new(TaxClass)
+	_, err := s.client.call(ctx, http.MethodPost, "taxes/classes", nil, class, created)
+	return created, err
+}
+
+// Delete deletes a tax class by slug, along with its rates.
+func (s *TaxClassesService) Delete(ctx context.Context, slug string) (*TaxClass, error) {
+	deleted := new(TaxClass)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("taxes", "classes", slug), forceParam(true), nil, deleted)
+	return deleted, err
+}
@@ -0,0 +1,116 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestParseTaxRatesCSV(t *testing.T) {
+	table := `Country code,State code,Postcode / ZIP,City,Rate %,Tax name,Priority,Compound,Shipping,Tax class
+US,CA,90001;90002,Los Angeles,7.25,State Tax,1,0,1,
+US,*,*,*,1.0000,Federal,2,1,0,reduced-rate
+`
+	rates, err := ParseTaxRatesCSV(strings.NewReader(table), "standard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("got %d rates, want 2", len(rates))
+	}
+	ca := rates[0]
+	if ca.Country != "US" || ca.State != "CA" || fmt.Sprint(ca.Postcodes) != "[90001 90002]" || ca.Cities[0] != "Los Angeles" {
+		t.Errorf("rate 0 = %+v", ca)
+	}
+	if ca.Rate != "7.25" || ca.Priority != 1 || ca.Compound || !ca.Shipping || ca.Class != "standard" {
+		t.Errorf("rate 0 = %+v", ca)
+	}
+	fed := rates[1]
+	if fed.State != "" || fed.Postcodes != nil || !fed.Compound || fed.Shipping || fed.Class != "reduced-rate" {
+		t.Errorf("rate 1 = %+v", fed)
+	}
+
+	if _, err := ParseTaxRatesCSV(strings.NewReader("h\nUS,CA,*,*,7,Tax,x,0,1\n"), ""); err == nil {
+		t.Error("expected error for invalid priority")
+	}
+}
+
+func TestTaxRatesLoad(t *testing.T) {
+	var batches []int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/taxes/batch" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		var req BatchRequest[TaxRate]
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &req)
+		batches = append(batches, len(req.Create))
+		resp := BatchResponse[TaxRate]{}
+		for i := range req.Create {
+			rate := req.Create[i]
+			rate.ID = len(batches)*1000 + i
+			resp.Create = append(resp.Create, rate)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	rates := make([]TaxRate, 150)
+	for i := range rates {
+		rates[i] = TaxRate{Country: "US", Rate: "5", Shipping: true}
+	}
+	created, err := client.TaxRates.Load(context.Background(), rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(batches) != "[100 50]" || len(created) != 150 || created[149].ID != 2049 {
+		t.Errorf("batches = %v, created = %d", batches, len(created))
+	}
+}
+
+func TestTaxRatesListByClass(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("class") != "reduced-rate" {
+			t.Errorf("class = %q", r.URL.Query().Get("class"))
+		}
+		w.Write([]byte(`[{"id":72,"country":"US","rate":"5.0000","name":"Reduced","shipping":false,"class":"reduced-rate"}]`))
+	})
+	rates, err := client.TaxRates.ListByClass(context.Background(), "reduced-rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 1 || rates[0].ID != 72 || rates[0].Class != "reduced-rate" {
+		t.Errorf("rates = %+v", rates)
+	}
+}
+
+func TestTaxClasses(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"slug":"standard","name":"Standard Rate"},{"slug":"zero-rate","name":"Zero Rate"}]`))
+			return
+		}
+		w.Write([]byte(`{"slug":"zero-rate","name":"Zero Rate"}`))
+	})
+	ctx := context.Background()
+	classes, err := client.TaxClasses.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 2 || classes[1].Slug != "zero-rate" {
+		t.Errorf("classes = %+v", classes)
+	}
+	if _, err := client.TaxClasses.Create(ctx, &TaxClass{Name: "Zero Rate"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.TaxClasses.Delete(ctx, "zero-rate"); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || path != "/wp-json/wc/v3/taxes/classes/zero-rate" {
+		t.Errorf("delete sent %s %s", method, path)
+	}
+}
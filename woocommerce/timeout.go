@@ -0,0 +1,35 @@
+package woocommerce
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type timeoutContextKey struct{}
+
+// WithTimeout returns a context that gives requests made with it a time
+// budget of d instead of Option.Timeout, so that for example a slow export
+// and a quick stock check can use the same client. Unlike
+// context.WithTimeout the budget starts when each request starts, so ctx
+// can be reused for several calls. Zero disables Option.Timeout.
+func WithTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutContextKey{}, d)
+}
+
+func timeoutFrom(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(timeoutContextKey{}).(time.Duration)
+	return d, ok
+}
+
+// cancelOnClose releases a request's timeout once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,50 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTimeout(t *testing.T) {
+	srv := newTestServer(t, false, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("slow") != "" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.Write([]byte(`{"id":1}`))
+	})
+	client, err := NewClient(srv.URL, "ck", "cs", &Option{Timeout: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slow := map[string][]string{"slow": {"1"}}
+	ctx := context.Background()
+	if _, err := client.Get(ctx, "orders", slow); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want deadline exceeded", err)
+	}
+	for _, ctx := range []context.Context{
+		WithTimeout(ctx, time.Second),
+		WithTimeout(ctx, 0),
+	} {
+		resp, err := client.Get(ctx, "orders", slow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(resp)
+		resp.Close()
+		if err != nil || string(body) != `{"id":1}` {
+			t.Errorf("body = %q, err = %v", body, err)
+		}
+	}
+	// The body of a fast request stays readable after the call returns.
+	order, err := client.Orders.Get(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 1 {
+		t.Errorf("order = %+v", order)
+	}
+}
@@ -0,0 +1,106 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// UsersService manages WordPress users at /wp/v2/users. A WooCommerce
+// customer's ID is the ID of its user. WordPress routes do not accept
+// consumer keys, so the client must be set up with Option.Auth, e.g.
+// ApplicationPasswordAuth for a user allowed to manage users.
+type UsersService service
+
+const usersEndpoint = "/wp/v2/users"
+
+// User is a WordPress user, read in the edit context so that Email, Roles
+// and Capabilities are included. Password is write-only.
+type User struct {
+	ID           int             `json:"id,omitempty"`
+	Username     string          `json:"username,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	FirstName    string          `json:"first_name,omitempty"`
+	LastName     string          `json:"last_name,omitempty"`
+	Email        string          `json:"email,omitempty"`
+	URL          string          `json:"url,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	Link         string          `json:"link,omitempty"`
+	Locale       string          `json:"locale,omitempty"`
+	Nickname     string          `json:"nickname,omitempty"`
+	Slug         string          `json:"slug,omitempty"`
+	Registered   WCTime          `json:"registered_date,omitzero"`
+	Roles        []string        `json:"roles,omitempty"`
+	Password     string          `json:"password,omitempty"`
+	Capabilities map[string]bool `json:"capabilities,omitempty"`
+}
+
+// editContext returns params with context=edit, which WordPress requires
+// to include private fields such as email and roles.
+func editContext(params url.Values) url.Values {
+	query := url.Values{"context": {"edit"}}
+	for k, v := range params {
+		query[k] = v
+	}
+	return query
+}
+
+// List lists users. Use params.Set("roles", "customer") to filter by role.
+func (s *UsersService) List(ctx context.Context, params *ListParams) ([]User, *Response, error) {
+	query, err := params.Values()
+	if err != nil {
+		return nil, nil, err
+	}
+	var users []User
+	resp, err := s.client.call(ctx, http.MethodGet, usersEndpoint, editContext(query), nil, &users)
+	return users, resp, err
+}
+
+func (s *UsersService) Get(ctx context.Context, id int) (*User, error) {
+	user := new(User)
+	_, err := s.client.call(ctx, http.MethodGet, usersEndpoint+"/"+buildPath(id), editContext(nil), nil, user)
+	return user, err
+}
+
+// Me returns the user the client authenticates as.
+func (s *UsersService) Me(ctx context.Context) (*User, error) {
+	user := new(User)
+	_, err := s.client.call(ctx, http.MethodGet, usersEndpoint+"/me", editContext(nil), nil, user)
+	return user, err
+}
+
+// Create creates a user. Username, Email and Password are required.
+func (s *UsersService) Create(ctx context.Context, user *User) (*User, error) {
+	created := new(User)
+	_, err := s.client.call(ctx, http.MethodPost, usersEndpoint, editContext(nil), user, created)
+	return created, err
+}
+
+func (s *UsersService) Update(ctx context.Context, id int, user *User) (*User, error) {
+	updated := new(User)
+	_, err := s.client.call(ctx, http.MethodPut, usersEndpoint+"/"+buildPath(id), editContext(nil), user, updated)
+	return updated, err
+}
+
+// SetRoles replaces the roles of a user, e.g. "customer" or
+// "shop_manager".
+func (s *UsersService) SetRoles(ctx context.Context, id int, roles ...string) (*User, error) {
+	if roles == nil {
+		roles = []string{}
+	}
+	updated := new(User)
+	_, err := s.client.call(ctx, http.MethodPut, usersEndpoint+"/"+buildPath(id), editContext(nil), map[string][]string{"roles": roles}, updated)
+	return updated, err
+}
+
+// Delete deletes a user, giving their posts to the user reassign.
+// WordPress cannot trash users, so the deletion is always permanent.
+func (s *UsersService) Delete(ctx context.Context, id, reassign int) (*User, error) {
+	var deleted struct {
+		Previous User `json:"previous"`
+	}
+	query := url.Values{"force": {"true"}, "reassign": {strconv.Itoa(reassign)}}
+	_, err := s.client.call(ctx, http.MethodDelete, usersEndpoint+"/"+buildPath(id), query, nil, &deleted)
+	return &deleted.Previous, err
+}
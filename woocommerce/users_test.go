@@ -0,0 +1,67 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+const userJSON = `{"id":25,"username":"john.doe","name":"John Doe","email":"john.doe@example.com","registered_date":"2017-03-21T16:11:04+00:00","roles":["customer"],"capabilities":{"customer":true}}`
+
+func TestUsers(t *testing.T) {
+	var method, path string
+	var query url.Values
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, query, body = r.Method, r.URL.Path, r.URL.Query(), nil
+		json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == http.MethodGet && path == "/wp-json/wp/v2/users":
+			w.Write([]byte("[" + userJSON + "]"))
+		case r.Method == http.MethodDelete:
+			w.Write([]byte(`{"deleted":true,"previous":` + userJSON + `}`))
+		default:
+			w.Write([]byte(userJSON))
+		}
+	})
+	ctx := context.Background()
+
+	users, _, err := client.Users.List(ctx, NewListParams().Set("roles", "customer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Email != "john.doe@example.com" || users[0].Roles[0] != "customer" || users[0].Registered.Year() != 2017 {
+		t.Errorf("users = %+v", users)
+	}
+	if query.Get("context") != "edit" || query.Get("roles") != "customer" {
+		t.Errorf("list query = %v", query)
+	}
+
+	if _, err := client.Users.Create(ctx, &User{Username: "john.doe", Email: "john.doe@example.com", Password: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wp/v2/users" || body["password"] != "secret" {
+		t.Errorf("create sent %s %s %v", method, path, body)
+	}
+
+	if _, err := client.Users.SetRoles(ctx, 25, "customer", "subscriber"); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wp/v2/users/25" || len(body["roles"].([]interface{})) != 2 {
+		t.Errorf("set roles sent %s %s %v", method, path, body)
+	}
+
+	if _, err := client.Users.Me(ctx); err != nil || path != "/wp-json/wp/v2/users/me" {
+		t.Errorf("me: %s, %v", path, err)
+	}
+
+	deleted, err := client.Users.Delete(ctx, 25, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.ID != 25 || query.Get("force") != "true" || query.Get("reassign") != "1" {
+		t.Errorf("delete sent %v, got %+v", query, deleted)
+	}
+}
@@ -0,0 +1,89 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// ProductVariationsService manages /products/{id}/variations. Fields left
+// at their zero value are omitted from create and update requests.
+type ProductVariationsService service
+
+type ProductVariation struct {
+	ID                int                `json:"id,omitempty"`
+	DateCreated       WCTime             `json:"date_created,omitzero"`
+	DateCreatedGMT    WCTime             `json:"date_created_gmt,omitzero"`
+	DateModified      WCTime             `json:"date_modified,omitzero"`
+	DateModifiedGMT   WCTime             `json:"date_modified_gmt,omitzero"`
+	Description       string             `json:"description,omitempty"`
+	Permalink         string             `json:"permalink,omitempty"`
+	SKU               string             `json:"sku,omitempty"`
+	Price             Decimal            `json:"price,omitzero"`
+	RegularPrice      Decimal            `json:"regular_price,omitzero"`
+	SalePrice         Decimal            `json:"sale_price,omitzero"`
+	DateOnSaleFrom    WCTime             `json:"date_on_sale_from,omitzero"`
+	DateOnSaleFromGMT WCTime             `json:"date_on_sale_from_gmt,omitzero"`
+	DateOnSaleTo      WCTime             `json:"date_on_sale_to,omitzero"`
+	DateOnSaleToGMT   WCTime             `json:"date_on_sale_to_gmt,omitzero"`
+	OnSale            bool               `json:"on_sale,omitempty"`
+	Status            string             `json:"status,omitempty"`
+	Purchasable       bool               `json:"purchasable,omitempty"`
+	Virtual           *bool              `json:"virtual,omitempty"`
+	Downloadable      *bool              `json:"downloadable,omitempty"`
+	Downloads         []ProductDownload  `json:"downloads,omitempty"`
+	TaxStatus         string             `json:"tax_status,omitempty"`
+	TaxClass          string             `json:"tax_class,omitempty"`
+	ManageStock       *bool              `json:"manage_stock,omitempty"`
+	StockQuantity     *int               `json:"stock_quantity,omitempty"`
+	StockStatus       string             `json:"stock_status,omitempty"`
+	Backorders        string             `json:"backorders,omitempty"`
+	Weight            string             `json:"weight,omitempty"`
+	Dimensions        *Dimensions        `json:"dimensions,omitempty"`
+	ShippingClass     string             `json:"shipping_class,omitempty"`
+	ShippingClassID   int                `json:"shipping_class_id,omitempty"`
+	Image             *ProductImage      `json:"image,omitempty"`
+	Attributes        []ProductAttribute `json:"attributes,omitempty"`
+	MenuOrder         int                `json:"menu_order,omitempty"`
+	MetaData          Meta               `json:"meta_data,omitempty"`
+}
+
+func (s *ProductVariationsService) List(ctx context.Context, productID int, params *ListParams) ([]ProductVariation, *Response, error) {
+	var variations []ProductVariation
+	resp, err := s.client.list(ctx, buildPath("products", productID, "variations"), params, &variations)
+	return variations, resp, err
+}
+
+// ListAll calls fn with every page of the product's variations.
+func (s *ProductVariationsService) ListAll(ctx context.Context, productID int, params *ListParams, fn func([]ProductVariation) error) error {
+	return listAll(ctx, s.client, buildPath("products", productID, "variations"), params, fn)
+}
+
+func (s *ProductVariationsService) Get(ctx context.Context, productID, id int) (*ProductVariation, error) {
+	variation := new(ProductVariation)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("products", productID, "variations", id), nil, nil, variation)
+	return variation, err
+}
+
+func (s *ProductVariationsService) Create(ctx context.Context, productID int, variation *ProductVariation) (*ProductVariation, error) {
+	created := new(ProductVariation)
+	_, err := s.client.call(ctx, http.MethodPost, buildPath("products", productID, "variations"), nil, variation, created)
+	return created, err
+}
+
+func (s *ProductVariationsService) Update(ctx context.Context, productID, id int, variation *ProductVariation) (*ProductVariation, error) {
+	updated := new(ProductVariation)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("products", productID, "variations", id), nil, variation, updated)
+	return updated, err
+}
+
+// Delete moves a variation to the trash, or deletes it permanently when
+// force is true.
+func (s *ProductVariationsService) Delete(ctx context.Context, productID, id int, force bool) (*ProductVariation, error) {
+	deleted := new(ProductVariation)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("products", productID, "variations", id), forceParam(force), nil, deleted)
+	return deleted, err
+}
+
+func (s *ProductVariationsService) Batch(ctx context.Context, productID int, req *BatchRequest[ProductVariation]) (*BatchResponse[ProductVariation], error) {
+	return batch(ctx, s.client, buildPath("products", productID, "variations", "batch"), req)
+}
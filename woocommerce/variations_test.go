@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const variationJSON = `{
+	"id": 733,
+	"sku": "PQ-19-BLK",
+	"price": "9.00",
+	"regular_price": "9.00",
+	"sale_price": "",
+	"on_sale": false,
+	"status": "publish",
+	"manage_stock": true,
+	"stock_quantity": 5,
+	"stock_status": "instock",
+	"image": {"id": 732, "src": "https://example.com/black.jpg"},
+	"attributes": [{"id": 6, "name": "Color", "option": "Black"}]
+}`
+
+func TestProductVariationsGet(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/products/22/variations/733" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(variationJSON))
+	})
+	v, err := client.ProductVariations.Get(context.Background(), 22, 733)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.SKU != "PQ-19-BLK" || *v.StockQuantity != 5 || v.Image.ID != 732 || v.Attributes[0].Option != "Black" {
+		t.Errorf("unexpected variation: %+v", v)
+	}
+}
+
+func TestProductVariationsCRUD(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet {
+			w.Write([]byte("[" + variationJSON + "]"))
+			return
+		}
+		w.Write([]byte(variationJSON))
+	})
+	ctx := context.Background()
+	list, _, err := client.ProductVariations.List(ctx, 22, nil)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("list = %v, %v", list, err)
+	}
+	if _, err := client.ProductVariations.Create(ctx, 22, &ProductVariation{RegularPrice: MustParseDecimal("9.00")}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPost || path != "/wp-json/wc/v3/products/22/variations" {
+		t.Errorf("create sent %s %s", method, path)
+	}
+	if _, err := client.ProductVariations.Update(ctx, 22, 733, &ProductVariation{SalePrice: MustParseDecimal("8.00")}); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/wp-json/wc/v3/products/22/variations/733" {
+		t.Errorf("update sent %s %s", method, path)
+	}
+	if _, err := client.ProductVariations.Delete(ctx, 22, 733, true); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete {
+		t.Errorf("delete sent %s", method)
+	}
+}
+
+func TestProductVariationsBatch(t *testing.T) {
+	var body map[string]json.RawMessage
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wp-json/wc/v3/products/22/variations/batch" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.Write([]byte(`{"create":[{"id":740,"regular_price":"10.00"}],"update":[` + variationJSON + `],"delete":[{"id":735}]}`))
+	})
+	resp, err := client.ProductVariations.Batch(context.Background(), 22, &BatchRequest[ProductVariation]{
+		Create: []ProductVariation{{RegularPrice: MustParseDecimal("10.00"), Attributes: []ProductAttribute{{ID: 6, Option: "White"}}}},
+		Update: []ProductVariation{{ID: 733, SalePrice: MustParseDecimal("8.00")}},
+		Delete: []int{735},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body["delete"]) != "[735]" || len(body) != 3 {
+		t.Errorf("batch body = %s", body)
+	}
+	if resp.Create[0].ID != 740 || resp.Update[0].ID != 733 || resp.Delete[0].ID != 735 {
+		t.Errorf("batch response = %+v", resp)
+	}
+}
@@ -0,0 +1,247 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultWatchInterval is how often a Watcher polls when Interval is not
+// set.
+const DefaultWatchInterval = time.Minute
+
+// WatchEvent types.
+const (
+	WatchCreated = "created"
+	WatchUpdated = "updated"
+)
+
+// WatchEvent reports an item created or modified since the last poll.
+type WatchEvent struct {
+	Resource string
+	Type     string
+	ID       int
+	Modified time.Time
+	Data     json.RawMessage
+}
+
+// Decode unmarshals the item, e.g. into an *Order.
+func (e *WatchEvent) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// CheckpointStore persists how far a Watcher has got for each resource.
+type CheckpointStore interface {
+	Load(resource string) (time.Time, error)
+	Save(resource string, t time.Time) error
+}
+
+// Watcher polls list endpoints with modified_after and emits an event for
+// every item created or modified since its checkpoint, as a fallback for
+// stores where webhooks are unreliable. Delivery is at least once: an item
+// can be emitted again after a restart or if it is modified while a poll
+// walks the pages.
+//
+// Resources must support the modified_after filter, which orders,
+// products and coupons do; customers do not.
+type Watcher struct {
+	// Resources to poll, "orders" if empty.
+	Resources []string
+	// Interval between polls.
+	Interval time.Duration
+	// Checkpoints keeps positions across restarts. Positions are kept in
+	// memory when it is nil.
+	Checkpoints CheckpointStore
+	// Since is where resources without a checkpoint start. The zero value
+	// starts from the first poll, skipping existing items.
+	Since time.Time
+	// OnError is called with errors from polls and checkpoint storage,
+	// which are retried at the next interval.
+	OnError func(err error)
+
+	client *Client
+	// emitted holds the modification time of items emitted in the
+	// checkpoint's second, which the next poll sees again.
+	emitted map[string]map[int]time.Time
+}
+
+// NewWatcher returns a Watcher for client polling resources, or orders if
+// none are given.
+func NewWatcher(client *Client, resources ...string) *Watcher {
+	return &Watcher{client: client, Resources: resources}
+}
+
+// Run polls until ctx is done, sending events to events, and returns the
+// context's error.
+func (w *Watcher) Run(ctx context.Context, events chan<- WatchEvent) error {
+	if w.Checkpoints == nil {
+		w.Checkpoints = NewMemoryCheckpoints()
+	}
+	w.emitted = make(map[string]map[int]time.Time)
+	resources := w.Resources
+	if len(resources) == 0 {
+		resources = []string{"orders"}
+	}
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	for {
+		for _, resource := range resources {
+			if err := w.poll(ctx, resource, events); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if w.OnError != nil {
+					w.OnError(err)
+				}
+			}
+		}
+		if err := sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context, resource string, events chan<- WatchEvent) error {
+	since, err := w.Checkpoints.Load(resource)
+	if err != nil {
+		return err
+	}
+	if since.IsZero() {
+		since = w.Since
+		if since.IsZero() {
+			since = time.Now().UTC().Truncate(time.Second)
+		}
+		if err := w.Checkpoints.Save(resource, since); err != nil {
+			return err
+		}
+	}
+	emitted := w.emitted[resource]
+	if emitted == nil {
+		emitted = make(map[int]time.Time)
+		w.emitted[resource] = emitted
+	}
+	// Pages are fetched from the checkpoint as it advances, and the
+	// checkpoint's second, which is fetched again, is deduplicated.
+	checkpoint := since
+	err = walkModified(ctx, w.client, resource, nil, func() time.Time { return checkpoint }, func(items []json.RawMessage) error {
+		for _, raw := range items {
+			var item struct {
+				ID              int    `json:"id"`
+				DateCreatedGMT  WCTime `json:"date_created_gmt"`
+				DateModifiedGMT WCTime `json:"date_modified_gmt"`
+			}
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			modified := item.DateModifiedGMT.Time
+			if modified.Before(since) || emitted[item.ID].Equal(modified) {
+				continue
+			}
+			event := WatchEvent{Resource: resource, Type: WatchUpdated, ID: item.ID, Modified: modified, Data: raw}
+			_, seen := emitted[item.ID]
+			if created := item.DateCreatedGMT; !created.IsZero() && !created.Before(since) && !seen {
+				event.Type = WatchCreated
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			emitted[item.ID] = modified
+			if modified.After(checkpoint) {
+				checkpoint = modified
+			}
+		}
+		return w.Checkpoints.Save(resource, checkpoint)
+	})
+	for id, modified := range emitted {
+		if modified.Before(checkpoint) {
+			delete(emitted, id)
+		}
+	}
+	return err
+}
+
+// MemoryCheckpoints is a CheckpointStore that forgets positions when the
+// process exits.
+type MemoryCheckpoints struct {
+	mu          sync.Mutex
+	checkpoints map[string]time.Time
+}
+
+func NewMemoryCheckpoints() *MemoryCheckpoints {
+	return &MemoryCheckpoints{checkpoints: make(map[string]time.Time)}
+}
+
+func (m *MemoryCheckpoints) Load(resource string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.checkpoints[resource], nil
+}
+
+func (m *MemoryCheckpoints) Save(resource string, t time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[resource] = t
+	return nil
+}
+
+// FileCheckpoints is a CheckpointStore keeping positions in a JSON file,
+// which is replaced atomically on every save.
+type FileCheckpoints struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+func (f *FileCheckpoints) Load(resource string) (time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	return checkpoints[resource], err
+}
+
+func (f *FileCheckpoints) Save(resource string, t time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[resource] = t
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+func (f *FileCheckpoints) read() (map[string]time.Time, error) {
+	checkpoints := make(map[string]time.Time)
+	data, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints, json.Unmarshal(data, &checkpoints)
+}
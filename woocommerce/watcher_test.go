@@ -0,0 +1,150 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type watchedOrder struct {
+	ID              int    `json:"id"`
+	Status          string `json:"status"`
+	DateCreatedGMT  string `json:"date_created_gmt"`
+	DateModifiedGMT string `json:"date_modified_gmt"`
+}
+
+func TestWatcher(t *testing.T) {
+	base := time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC)
+	at := func(s int) string { return base.Add(time.Duration(s) * time.Second).Format(wcTimeLayout) }
+	var mu sync.Mutex
+	orders := map[int]*watchedOrder{
+		1: {ID: 1, Status: "completed", DateCreatedGMT: at(-60), DateModifiedGMT: at(-30)},
+		2: {ID: 2, Status: "processing", DateCreatedGMT: at(-60), DateModifiedGMT: at(5)},
+		3: {ID: 3, Status: "pending", DateCreatedGMT: at(10), DateModifiedGMT: at(10)},
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		after, _ := time.Parse(time.RFC3339, r.URL.Query().Get("modified_after"))
+		list := []*watchedOrder{}
+		for _, o := range orders {
+			if o.DateModifiedGMT > after.Format(wcTimeLayout) {
+				list = append(list, o)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].DateModifiedGMT < list[j].DateModifiedGMT })
+		json.NewEncoder(w).Encode(list)
+	})
+
+	checkpoints := &FileCheckpoints{Path: filepath.Join(t.TempDir(), "checkpoints.json")}
+	w := NewWatcher(client)
+	w.Interval = time.Millisecond
+	w.Since = base
+	w.Checkpoints = checkpoints
+	w.OnError = func(err error) { t.Error(err) }
+	events := make(chan WatchEvent)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx, events) }()
+
+	next := func() WatchEvent {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("no event")
+		}
+		return WatchEvent{}
+	}
+	if e := next(); e.ID != 2 || e.Type != WatchUpdated {
+		t.Errorf("event = %+v", e)
+	}
+	e := next()
+	var order Order
+	if err := e.Decode(&order); err != nil || e.ID != 3 || e.Type != WatchCreated || order.Status != "pending" {
+		t.Errorf("event = %+v, order = %+v", e, order)
+	}
+
+	// Order 3 changes again within the checkpoint's second.
+	mu.Lock()
+	orders[3].Status = "processing"
+	orders[3].DateModifiedGMT = at(11)
+	mu.Unlock()
+	if e := next(); e.ID != 3 || e.Type != WatchUpdated {
+		t.Errorf("event = %+v", e)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run returned %v", err)
+	}
+	if cp, _ := checkpoints.Load("orders"); !cp.Equal(base.Add(11 * time.Second)) {
+		t.Errorf("checkpoint = %v", cp)
+	}
+}
+
+func TestWatcherModifiedDuringWalk(t *testing.T) {
+	base := time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC)
+	at := func(s int) string { return base.Add(time.Duration(s) * time.Second).Format(wcTimeLayout) }
+	var mu sync.Mutex
+	var orders []*watchedOrder
+	for id := 1; id <= 150; id++ {
+		orders = append(orders, &watchedOrder{ID: id, DateCreatedGMT: at(-60), DateModifiedGMT: at(id)})
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		q := r.URL.Query()
+		after, _ := time.Parse(time.RFC3339, q.Get("modified_after"))
+		list := []*watchedOrder{}
+		for _, o := range orders {
+			if o.DateModifiedGMT > after.Format(wcTimeLayout) {
+				list = append(list, o)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].DateModifiedGMT < list[j].DateModifiedGMT })
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		start := min((page-1)*perPage, len(list))
+		json.NewEncoder(w).Encode(list[start:min(start+perPage, len(list))])
+	})
+
+	w := NewWatcher(client)
+	w.Interval = time.Hour
+	w.Since = base
+	w.OnError = func(err error) { t.Error(err) }
+	events := make(chan WatchEvent)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx, events)
+
+	seen := make(map[int]int)
+	for len(seen) < 150 {
+		select {
+		case e := <-events:
+			seen[e.ID]++
+			if e.ID == 50 {
+				// Order 10 moves to the end of the listing, which shifts
+				// order 101 onto the first page.
+				mu.Lock()
+				orders[9].DateModifiedGMT = at(200)
+				mu.Unlock()
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("missed %d orders", 150-len(seen))
+		}
+	}
+	select {
+	case e := <-events:
+		if e.ID != 10 || seen[101] != 1 {
+			t.Errorf("event = %+v, order 101 seen %d times", e, seen[101])
+		}
+	case <-time.After(time.Second):
+		t.Fatal("order 10 was not emitted again")
+	}
+}
@@ -0,0 +1,89 @@
+package woocommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// wcTimeLayout is the format of the API's date fields, which carry no
+// offset.
+const wcTimeLayout = "2006-01-02T15:04:05"
+
+// wcTimeLayouts are also accepted when decoding: RFC 3339 dates from the
+// legacy API and plugins, MySQL-style dates and the minute precision of
+// booking slots.
+var wcTimeLayouts = []string{wcTimeLayout, time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04", time.DateOnly}
+
+// WCTime is a date field such as date_created or date_created_gmt. Dates
+// without an offset are read as UTC, which is right for the *_gmt fields;
+// the other fields hold the wall clock of the store's timezone, which Wall
+// attaches. Null and empty dates decode to the zero WCTime, which is left
+// out of request bodies by omitzero.
+//
+// A WCTime is sent as its own wall clock, so set the *_gmt fields from a
+// UTC time, or the others from a time in the store's timezone.
+type WCTime struct {
+	time.Time
+}
+
+// NewWCTime returns t as a WCTime in UTC, ready for a *_gmt field.
+func NewWCTime(t time.Time) WCTime {
+	return WCTime{t.UTC()}
+}
+
+// ParseWCTime parses a date as sent by the API. An empty s gives the zero
+// WCTime.
+func ParseWCTime(s string) (WCTime, error) {
+	if s == "" {
+		return WCTime{}, nil
+	}
+	for _, layout := range wcTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return WCTime{t}, nil
+		}
+	}
+	return WCTime{}, fmt.Errorf("Invalid date %q", s)
+}
+
+// Wall returns the wall clock of t in loc, for the fields in the store's
+// timezone, e.g. order.DateCreated.Wall(storeLocation).
+func (t WCTime) Wall(loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// String formats t as the API does, or "" if it is zero.
+func (t WCTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(wcTimeLayout)
+}
+
+// MarshalJSON encodes t as the API expects, or null if it is zero, which
+// clears dates such as date_on_sale_to.
+func (t WCTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON accepts a date string, "" or null.
+func (t *WCTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = WCTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := ParseWCTime(s)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
@@ -0,0 +1,53 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWCTimeJSON(t *testing.T) {
+	var order Order
+	data := `{"date_created":"2017-03-22T16:28:02","date_created_gmt":"2017-03-22T19:28:02","date_paid":null,"date_completed":"","date_modified_gmt":"2017-03-22T19:28:08Z"}`
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2017, 3, 22, 19, 28, 2, 0, time.UTC)
+	if !order.DateCreatedGMT.Equal(want) || !order.DateModifiedGMT.Equal(want.Add(6*time.Second)) {
+		t.Errorf("gmt dates = %v, %v", order.DateCreatedGMT, order.DateModifiedGMT)
+	}
+	if !order.DatePaid.IsZero() || !order.DateCompleted.IsZero() {
+		t.Errorf("empty dates = %v, %v", order.DatePaid, order.DateCompleted)
+	}
+	store := time.FixedZone("BRT", -3*60*60)
+	if local := order.DateCreated.Wall(store); !local.Equal(want) {
+		t.Errorf("site date = %v, want %v", local, want)
+	}
+	if err := json.Unmarshal([]byte(`{"date_created":"yesterday"}`), &order); err == nil {
+		t.Error("expected error for invalid date")
+	}
+
+	out, err := json.Marshal(&Product{DateOnSaleFromGMT: NewWCTime(want.In(store)), DateOnSaleTo: WCTime{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"date_on_sale_from_gmt":"2017-03-22T19:28:02"}` {
+		t.Errorf("product = %s", out)
+	}
+	if out, _ := json.Marshal(WCTime{}); string(out) != "null" {
+		t.Errorf("zero = %s", out)
+	}
+}
+
+func TestParseWCTime(t *testing.T) {
+	for _, s := range []string{"2024-01-01T00:00:00", "2024-01-01 00:00:00", "2024-01-01", "2024-01-01T00:00:00+00:00"} {
+		got, err := ParseWCTime(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		if got.String() != "2024-01-01T00:00:00" {
+			t.Errorf("%q = %s", s, got)
+		}
+	}
+}
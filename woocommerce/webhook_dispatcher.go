@@ -0,0 +1,123 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// WebhookDispatcher decodes webhook deliveries into the typed models and
+// routes them by topic to the registered callbacks. Deliveries for topics
+// without a callback are acknowledged and dropped.
+//
+//	d := woocommerce.NewWebhookDispatcher()
+//	d.OnOrderCreated(func(ctx context.Context, order *woocommerce.Order) error {
+//		return fulfil(ctx, order)
+//	})
+//	http.Handle("/hooks", d.Handler(secret))
+//
+// Payloads for deleted resources usually only carry the ID.
+type WebhookDispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string]func(ctx context.Context, delivery *WebhookDelivery) error
+}
+
+func NewWebhookDispatcher() *WebhookDispatcher {
+	return &WebhookDispatcher{handlers: make(map[string]func(context.Context, *WebhookDelivery) error)}
+}
+
+// Handle registers fn for a topic, such as "order.created" or a custom
+// "action.*" topic, replacing any earlier callback for it.
+func (d *WebhookDispatcher) Handle(topic string, fn func(ctx context.Context, delivery *WebhookDelivery) error) {
+	d.mu.Lock()
+	d.handlers[topic] = fn
+	d.mu.Unlock()
+}
+
+// Dispatch passes delivery to the callback for its topic. It can be used
+// as WebhookHandler.Handle.
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, delivery *WebhookDelivery) error {
+	d.mu.RLock()
+	fn := d.handlers[delivery.Topic]
+	d.mu.RUnlock()
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx, delivery)
+}
+
+// Handler returns a WebhookHandler for webhooks signed with secret that
+// dispatches verified deliveries.
+func (d *WebhookDispatcher) Handler(secret string) *WebhookHandler {
+	return NewWebhookHandler(secret, d.Dispatch)
+}
+
+func onWebhook[T any](d *WebhookDispatcher, topic string, fn func(context.Context, *T) error) {
+	d.Handle(topic, func(ctx context.Context, delivery *WebhookDelivery) error {
+		v := new(T)
+		if err := json.Unmarshal(delivery.Body, v); err != nil {
+			return err
+		}
+		return fn(ctx, v)
+	})
+}
+
+func (d *WebhookDispatcher) OnOrderCreated(fn func(ctx context.Context, order *Order) error) {
+	onWebhook(d, "order.created", fn)
+}
+
+func (d *WebhookDispatcher) OnOrderUpdated(fn func(ctx context.Context, order *Order) error) {
+	onWebhook(d, "order.updated", fn)
+}
+
+func (d *WebhookDispatcher) OnOrderDeleted(fn func(ctx context.Context, order *Order) error) {
+	onWebhook(d, "order.deleted", fn)
+}
+
+func (d *WebhookDispatcher) OnOrderRestored(fn func(ctx context.Context, order *Order) error) {
+	onWebhook(d, "order.restored", fn)
+}
+
+func (d *WebhookDispatcher) OnProductCreated(fn func(ctx context.Context, product *Product) error) {
+	onWebhook(d, "product.created", fn)
+}
+
+func (d *WebhookDispatcher) OnProductUpdated(fn func(ctx context.Context, product *Product) error) {
+	onWebhook(d, "product.updated", fn)
+}
+
+func (d *WebhookDispatcher) OnProductDeleted(fn func(ctx context.Context, product *Product) error) {
+	onWebhook(d, "product.deleted", fn)
+}
+
+func (d *WebhookDispatcher) OnProductRestored(fn func(ctx context.Context, product *Product) error) {
+	onWebhook(d, "product.restored", fn)
+}
+
+func (d *WebhookDispatcher) OnCustomerCreated(fn func(ctx context.Context, customer *Customer) error) {
+	onWebhook(d, "customer.created", fn)
+}
+
+func (d *WebhookDispatcher) OnCustomerUpdated(fn func(ctx context.Context, customer *Customer) error) {
+	onWebhook(d, "customer.updated", fn)
+}
+
+func (d *WebhookDispatcher) OnCustomerDeleted(fn func(ctx context.Context, customer *Customer) error) {
+	onWebhook(d, "customer.deleted", fn)
+}
+
+func (d *WebhookDispatcher) OnCouponCreated(fn func(ctx context.Context, coupon *Coupon) error) {
+	onWebhook(d, "coupon.created", fn)
+}
+
+func (d *WebhookDispatcher) OnCouponUpdated(fn func(ctx context.Context, coupon *Coupon) error) {
+	onWebhook(d, "coupon.updated", fn)
+}
+
+func (d *WebhookDispatcher) OnCouponDeleted(fn func(ctx context.Context, coupon *Coupon) error) {
+	onWebhook(d, "coupon.deleted", fn)
+}
+
+func (d *WebhookDispatcher) OnCouponRestored(fn func(ctx context.Context, coupon *Coupon) error) {
+	onWebhook(d, "coupon.restored", fn)
+}
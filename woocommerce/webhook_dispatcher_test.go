@@ -0,0 +1,54 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	d := NewWebhookDispatcher()
+	var order *Order
+	var deleted *Product
+	d.OnOrderCreated(func(ctx context.Context, o *Order) error {
+		order = o
+		return nil
+	})
+	d.OnProductDeleted(func(ctx context.Context, p *Product) error {
+		deleted = p
+		return nil
+	})
+	h := d.Handler("s3cret")
+
+	for _, req := range []*http.Request{
+		newWebhookRequest("s3cret", "order.created", `{"id":727,"status":"processing","total":"29.35","line_items":[{"id":315,"name":"Woo Single #1","quantity":2}]}`),
+		newWebhookRequest("s3cret", "product.deleted", `{"id":794}`),
+		newWebhookRequest("s3cret", "coupon.updated", `{"id":719}`),
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: status = %d", req.Header.Get("X-WC-Webhook-Topic"), w.Code)
+		}
+	}
+	if order == nil || order.ID != 727 || order.Status != "processing" || len(order.LineItems) != 1 {
+		t.Errorf("order = %+v", order)
+	}
+	if deleted == nil || deleted.ID != 794 {
+		t.Errorf("deleted product = %+v", deleted)
+	}
+}
+
+func TestWebhookDispatcherBadPayload(t *testing.T) {
+	d := NewWebhookDispatcher()
+	d.OnCustomerCreated(func(ctx context.Context, c *Customer) error {
+		t.Error("callback called for an invalid payload")
+		return nil
+	})
+	w := httptest.NewRecorder()
+	d.Handler("s3cret").ServeHTTP(w, newWebhookRequest("s3cret", "customer.created", `{"id":"x"`))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d", w.Code)
+	}
+}
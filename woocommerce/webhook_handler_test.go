@@ -0,0 +1,124 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newWebhookRequest(secret, topic, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-WC-Webhook-Source", "https://shop.example.com/")
+	req.Header.Set("X-WC-Webhook-Topic", topic)
+	req.Header.Set("X-WC-Webhook-Resource", strings.Split(topic, ".")[0])
+	req.Header.Set("X-WC-Webhook-Event", strings.Split(topic, ".")[1])
+	req.Header.Set("X-WC-Webhook-ID", "12")
+	req.Header.Set("X-WC-Webhook-Delivery-ID", "345")
+	req.Header.Set("X-WC-Webhook-Signature", SignHMAC(secret, []byte(body)))
+	return req
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var got *WebhookDelivery
+	h := NewWebhookHandler("s3cret", func(ctx context.Context, d *WebhookDelivery) error {
+		got = d
+		return nil
+	})
+	h.Source = "https://shop.example.com"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newWebhookRequest("s3cret", "order.created", `{"id":727}`))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	if got == nil || got.Topic != "order.created" || got.Resource != "order" || got.Event != "created" ||
+		got.WebhookID != 12 || got.DeliveryID != 345 || string(got.Body) != `{"id":727}` {
+		t.Errorf("delivery = %+v", got)
+	}
+}
+
+func TestWebhookHandlerRejects(t *testing.T) {
+	called := false
+	h := NewWebhookHandler("s3cret", func(ctx context.Context, d *WebhookDelivery) error {
+		called = true
+		return nil
+	})
+	h.Topics = []string{"order.created", "order.updated"}
+	h.Source = "https://shop.example.com"
+
+	tampered := newWebhookRequest("s3cret", "order.created", `{"id":727}`)
+	tampered.Body = http.NoBody
+	otherSource := newWebhookRequest("s3cret", "order.created", `{"id":727}`)
+	otherSource.Header.Set("X-WC-Webhook-Source", "https://evil.example.com/")
+	get := newWebhookRequest("s3cret", "order.created", `{"id":727}`)
+	get.Method = http.MethodGet
+
+	for name, tc := range map[string]struct {
+		req  *http.Request
+		want int
+	}{
+		"wrong secret": {newWebhookRequest("other", "order.created", `{"id":727}`), http.StatusUnauthorized},
+		"tampered":     {tampered, http.StatusUnauthorized},
+		"topic":        {newWebhookRequest("s3cret", "product.deleted", `{"id":1}`), http.StatusBadRequest},
+		"source":       {otherSource, http.StatusBadRequest},
+		"method":       {get, http.StatusMethodNotAllowed},
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, tc.req)
+		if w.Code != tc.want {
+			t.Errorf("%s: status = %d, want %d", name, w.Code, tc.want)
+		}
+	}
+	if called {
+		t.Error("handler called for a rejected delivery")
+	}
+
+	h.MaxBodySize = 4
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newWebhookRequest("s3cret", "order.created", `{"id":727}`))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("large body: status = %d", w.Code)
+	}
+}
+
+func TestWebhookHandlerPingAndFailure(t *testing.T) {
+	h := NewWebhookHandler("s3cret", func(ctx context.Context, d *WebhookDelivery) error {
+		return errors.New("database down")
+	})
+	ping := httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader("webhook_id=12"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, ping)
+	if w.Code != http.StatusOK {
+		t.Errorf("ping: status = %d", w.Code)
+	}
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newWebhookRequest("s3cret", "order.created", `{"id":727}`))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("failed delivery: status = %d", w.Code)
+	}
+}
+
+func TestWebhookHandlerWithoutSecret(t *testing.T) {
+	called := false
+	h := NewWebhookHandler("", func(ctx context.Context, d *WebhookDelivery) error {
+		called = true
+		return nil
+	})
+	// Without a secret the signature is trivially forged.
+	for _, req := range []*http.Request{
+		newWebhookRequest("", "order.created", `{"id":727}`),
+		httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader("webhook_id=12")),
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("status = %d, want 500", w.Code)
+		}
+	}
+	if called {
+		t.Error("delivery handled without a secret")
+	}
+}
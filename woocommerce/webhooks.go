@@ -0,0 +1,82 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhooksService manages /webhooks.
+type WebhooksService service
+
+type Webhook struct {
+	ID              int      `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Status          string   `json:"status,omitempty"`
+	Topic           string   `json:"topic,omitempty"`
+	Resource        string   `json:"resource,omitempty"`
+	Event           string   `json:"event,omitempty"`
+	Hooks           []string `json:"hooks,omitempty"`
+	DeliveryURL     string   `json:"delivery_url,omitempty"`
+	Secret          string   `json:"secret,omitempty"`
+	DateCreated     WCTime   `json:"date_created,omitzero"`
+	DateCreatedGMT  WCTime   `json:"date_created_gmt,omitzero"`
+	DateModified    WCTime   `json:"date_modified,omitzero"`
+	DateModifiedGMT WCTime   `json:"date_modified_gmt,omitzero"`
+}
+
+// Webhook statuses.
+const (
+	WebhookStatusActive   = "active"
+	WebhookStatusPaused   = "paused"
+	WebhookStatusDisabled = "disabled"
+)
+
+func (s *WebhooksService) List(ctx context.Context, params *ListParams) ([]Webhook, *Response, error) {
+	var webhooks []Webhook
+	resp, err := s.client.list(ctx, "webhooks", params, &webhooks)
+	return webhooks, resp, err
+}
+
+// ListByStatus lists webhooks with the given status, e.g.
+// WebhookStatusDisabled. Other filters can be passed in params.
+func (s *WebhooksService) ListByStatus(ctx context.Context, params *ListParams, status string) ([]Webhook, *Response, error) {
+	if params == nil {
+		params = NewListParams()
+	}
+	return s.List(ctx, params.Status(status))
+}
+
+// ListAll calls fn with every page of webhooks matching params.
+func (s *WebhooksService) ListAll(ctx context.Context, params *ListParams, fn func([]Webhook) error) error {
+	return listAll(ctx, s.client, "webhooks", params, fn)
+}
+
+func (s *WebhooksService) Get(ctx context.Context, id int) (*Webhook, error) {
+	webhook := new(Webhook)
+	_, err := s.client.call(ctx, http.MethodGet, buildPath("webhooks", id), nil, nil, webhook)
+	return webhook, err
+}
+
+func (s *WebhooksService) Create(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	created := new(Webhook)
+	_, err := s.client.call(ctx, http.MethodPost, "webhooks", nil, webhook, created)
+	return created, err
+}
+
+func (s *WebhooksService) Update(ctx context.Context, id int, webhook *Webhook) (*Webhook, error) {
+	updated := new(Webhook)
+	_, err := s.client.call(ctx, http.MethodPut, buildPath("webhooks", id), nil, webhook, updated)
+	return updated, err
+}
+
+// Delete deletes a webhook. Webhooks cannot be trashed, so the request is
+// always forced.
+func (s *WebhooksService) Delete(ctx context.Context, id int) (*Webhook, error) {
+	deleted := new(Webhook)
+	_, err := s.client.call(ctx, http.MethodDelete, buildPath("webhooks", id), forceParam(true), nil, deleted)
+	return deleted, err
+}
+
+func (s *WebhooksService) Batch(ctx context.Context, req *BatchRequest[Webhook]) (*BatchResponse[Webhook], error) {
+	return batch(ctx, s.client, "webhooks/batch", req)
+}
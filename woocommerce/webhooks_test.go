@@ -0,0 +1,75 @@
+package woocommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const webhookJSON = `{"id":142,"name":"Order created","status":"active","topic":"order.created","resource":"order","event":"created","hooks":["woocommerce_new_order"],"delivery_url":"https://example.com/hooks/orders"}`
+
+func TestWebhooksCreate(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/wp-json/wc/v3/webhooks" {
+			t.Errorf("create sent %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.Write([]byte(webhookJSON))
+	})
+	webhook, err := client.Webhooks.Create(context.Background(), &Webhook{
+		Name:        "Order created",
+		Topic:       "order.created",
+		DeliveryURL: "https://example.com/hooks/orders",
+		Secret:      "s3cret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.ID != 142 || webhook.Status != WebhookStatusActive || webhook.Hooks[0] != "woocommerce_new_order" {
+		t.Errorf("webhook = %+v", webhook)
+	}
+	if body["topic"] != "order.created" || body["secret"] != "s3cret" {
+		t.Errorf("body = %v", body)
+	}
+	if _, ok := body["id"]; ok {
+		t.Errorf("zero id was sent: %v", body)
+	}
+}
+
+func TestWebhooksListByStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("status"); got != "disabled" {
+			t.Errorf("status = %q", got)
+		}
+		if got := r.URL.Query().Get("per_page"); got != "20" {
+			t.Errorf("per_page = %q", got)
+		}
+		w.Write([]byte("[" + webhookJSON + "]"))
+	})
+	webhooks, _, err := client.Webhooks.ListByStatus(context.Background(), NewListParams().PerPage(20), WebhookStatusDisabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 {
+		t.Errorf("webhooks = %+v", webhooks)
+	}
+}
+
+func TestWebhooksDelete(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/wp-json/wc/v3/webhooks/142" {
+			t.Errorf("delete sent %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("force") != "true" {
+			t.Errorf("delete was not forced: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(webhookJSON))
+	})
+	if _, err := client.Webhooks.Delete(context.Background(), 142); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,501 @@
+// Package woocommercetest provides an in-memory WooCommerce store for
+// integration tests.
+//
+//	store := woocommercetest.NewServer()
+//	defer store.Close()
+//	store.AddProduct(woocommerce.Product{Name: "Hoodie", SKU: "H-1"})
+//	client, _ := store.Client(nil)
+//
+// The store keeps products, orders and customers as JSON objects, so any
+// field a client sends is returned as is. It supports CRUD, batches, the
+// status, search, sku, email and modified_after filters, ordering by id
+// or modified, and paginated lists with the X-WP-Total and
+// X-WP-TotalPages headers. Requests must
+// carry the store's consumer key; OAuth signatures are not verified.
+package woocommercetest
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+)
+
+// Default credentials accepted by a new Server.
+const (
+	ConsumerKey    = "ck_test"
+	ConsumerSecret = "cs_test"
+)
+
+// Server is a fake store served over HTTP by an httptest.Server.
+type Server struct {
+	URL            string
+	ConsumerKey    string
+	ConsumerSecret string
+
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	nextID    int
+	resources map[string]map[int]map[string]interface{}
+}
+
+// resourceInfo describes a resource served by the store.
+type resourceInfo struct {
+	invalidID     string
+	trashable     bool
+	defaultStatus string
+}
+
+var resources = map[string]resourceInfo{
+	"products":  {invalidID: "woocommerce_rest_product_invalid_id", trashable: true, defaultStatus: "publish"},
+	"orders":    {invalidID: "woocommerce_rest_shop_order_invalid_id", trashable: true, defaultStatus: "pending"},
+	"customers": {invalidID: "woocommerce_rest_invalid_id"},
+}
+
+// NewServer starts a store on plain HTTP, where clients authenticate with
+// OAuth.
+func NewServer() *Server {
+	s := newServer()
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// NewTLSServer starts a store on HTTPS, where clients send their keys in
+// the query string or as basic auth. Clients must not verify the test
+// certificate, which is the default.
+func NewTLSServer() *Server {
+	s := newServer()
+	s.srv = httptest.NewTLSServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+func newServer() *Server {
+	s := &Server{
+		ConsumerKey:    ConsumerKey,
+		ConsumerSecret: ConsumerSecret,
+		nextID:         1,
+		resources:      make(map[string]map[int]map[string]interface{}),
+	}
+	for name := range resources {
+		s.resources[name] = make(map[int]map[string]interface{})
+	}
+	return s
+}
+
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client for the store using its credentials.
+func (s *Server) Client(option *woocommerce.Option) (*woocommerce.Client, error) {
+	return woocommerce.NewClient(s.URL, s.ConsumerKey, s.ConsumerSecret, option)
+}
+
+func (s *Server) AddProduct(product woocommerce.Product) woocommerce.Product {
+	var created woocommerce.Product
+	s.add("products", product, &created)
+	return created
+}
+
+func (s *Server) AddOrder(order woocommerce.Order) woocommerce.Order {
+	var created woocommerce.Order
+	s.add("orders", order, &created)
+	return created
+}
+
+func (s *Server) AddCustomer(customer woocommerce.Customer) woocommerce.Customer {
+	var created woocommerce.Customer
+	s.add("customers", customer, &created)
+	return created
+}
+
+// Product returns a stored product, including trashed ones.
+func (s *Server) Product(id int) (woocommerce.Product, bool) {
+	var product woocommerce.Product
+	return product, s.get("products", id, &product)
+}
+
+func (s *Server) Order(id int) (woocommerce.Order, bool) {
+	var order woocommerce.Order
+	return order, s.get("orders", id, &order)
+}
+
+func (s *Server) Customer(id int) (woocommerce.Customer, bool) {
+	var customer woocommerce.Customer
+	return customer, s.get("customers", id, &customer)
+}
+
+func (s *Server) add(resource string, in, out interface{}) {
+	obj := toObject(in)
+	s.mu.Lock()
+	created := s.create(resource, obj)
+	s.mu.Unlock()
+	fromObject(created, out)
+}
+
+func (s *Server) get(resource string, id int, out interface{}) bool {
+	s.mu.Lock()
+	obj, ok := s.resources[resource][id]
+	s.mu.Unlock()
+	if ok {
+		fromObject(obj, out)
+	}
+	return ok
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "woocommerce_rest_authentication_error", "Consumer key is invalid.")
+		return
+	}
+	path := r.URL.Path
+	for _, prefix := range []string{"/wp-json/wc/v3/", "/wc-api/v3/"} {
+		path = strings.TrimPrefix(path, prefix)
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	info, ok := resources[segments[0]]
+	if !ok || len(segments) > 2 {
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+		return
+	}
+	resource := segments[0]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, resource)
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		obj, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if status, code, message := s.validate(resource, 0, obj); status != 0 {
+			writeError(w, status, code, message)
+			return
+		}
+		writeJSON(w, http.StatusCreated, s.create(resource, obj))
+	case len(segments) == 2 && segments[1] == "batch" && r.Method == http.MethodPost:
+		s.batch(w, r, resource, info)
+	case len(segments) == 2:
+		id, err := strconv.Atoi(segments[1])
+		obj, ok := s.resources[resource][id]
+		if err != nil || !ok {
+			writeError(w, http.StatusNotFound, info.invalidID, "Invalid ID.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, obj)
+		case http.MethodPut, http.MethodPatch, http.MethodPost:
+			changes, ok := decodeObject(w, r)
+			if !ok {
+				return
+			}
+			if status, code, message := s.validate(resource, id, changes); status != 0 {
+				writeError(w, status, code, message)
+				return
+			}
+			writeJSON(w, http.StatusOK, s.update(obj, changes))
+		case http.MethodDelete:
+			force := r.URL.Query().Get("force") == "true"
+			if !force && !info.trashable {
+				writeError(w, http.StatusNotImplemented, "woocommerce_rest_trash_not_supported", "Resource does not support trashing.")
+				return
+			}
+			writeJSON(w, http.StatusOK, s.delete(resource, id, force))
+		default:
+			writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+		}
+	default:
+		writeError(w, http.StatusNotFound, "rest_no_route", "No route was found matching the URL and request method.")
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	query := r.URL.Query()
+	if key := query.Get("consumer_key"); key != "" {
+		return key == s.ConsumerKey && query.Get("consumer_secret") == s.ConsumerSecret
+	}
+	if key, secret, ok := r.BasicAuth(); ok {
+		return key == s.ConsumerKey && secret == s.ConsumerSecret
+	}
+	if key := query.Get("oauth_consumer_key"); key != "" {
+		return key == s.ConsumerKey && query.Get("oauth_signature") != ""
+	}
+	return false
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, resource string) {
+	query := r.URL.Query()
+	page, perPage := 1, 10
+	if v := query.Get("page"); v != "" {
+		page, _ = strconv.Atoi(v)
+	}
+	if v := query.Get("per_page"); v != "" {
+		perPage, _ = strconv.Atoi(v)
+	}
+	if page < 1 || perPage < 1 || perPage > 100 {
+		writeError(w, http.StatusBadRequest, "rest_invalid_param", "Invalid parameter(s): page, per_page")
+		return
+	}
+	var items []map[string]interface{}
+	for _, obj := range s.resources[resource] {
+		if matches(obj, query) {
+			items = append(items, obj)
+		}
+	}
+	// Items are listed newest first unless ordered by id or modified.
+	less := func(a, b map[string]interface{}) bool { return idOf(a) < idOf(b) }
+	if query.Get("orderby") == "modified" {
+		less = func(a, b map[string]interface{}) bool {
+			ma, _ := a["date_modified_gmt"].(string)
+			mb, _ := b["date_modified_gmt"].(string)
+			return ma < mb || ma == mb && idOf(a) < idOf(b)
+		}
+	}
+	asc := query.Get("order") == "asc" && (query.Get("orderby") == "id" || query.Get("orderby") == "modified")
+	sort.Slice(items, func(i, j int) bool {
+		if asc {
+			return less(items[i], items[j])
+		}
+		return less(items[j], items[i])
+	})
+
+	total := len(items)
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+	w.Header().Set("X-WP-Total", strconv.Itoa(total))
+	w.Header().Set("X-WP-TotalPages", strconv.Itoa(int(math.Ceil(float64(total)/float64(perPage)))))
+	pageItems := items[start:end]
+	if pageItems == nil {
+		pageItems = []map[string]interface{}{}
+	}
+	writeJSON(w, http.StatusOK, pageItems)
+}
+
+// matches applies the list filters supported by the store.
+func matches(obj map[string]interface{}, query map[string][]string) bool {
+	get := func(key string) string {
+		if v := query[key]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	if status := get("status"); status != "" && status != "any" {
+		found := false
+		for _, s := range strings.Split(status, ",") {
+			if obj["status"] == s {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	} else if obj["status"] == "trash" {
+		return false
+	}
+	if after := get("modified_after"); after != "" {
+		t, err := time.Parse(time.RFC3339, after)
+		if modified, _ := obj["date_modified_gmt"].(string); err == nil && modified <= t.UTC().Format("2006-01-02T15:04:05") {
+			return false
+		}
+	}
+	if sku := get("sku"); sku != "" && obj["sku"] != sku {
+		return false
+	}
+	if email := get("email"); email != "" && obj["email"] != email {
+		return false
+	}
+	if search := strings.ToLower(get("search")); search != "" {
+		found := false
+		for _, key := range []string{"name", "sku", "email", "first_name", "last_name"} {
+			if v, ok := obj[key].(string); ok && strings.Contains(strings.ToLower(v), search) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks a create (id 0) or update of resource.
+func (s *Server) validate(resource string, id int, obj map[string]interface{}) (int, string, string) {
+	if resource != "customers" {
+		return 0, "", ""
+	}
+	email, _ := obj["email"].(string)
+	if id == 0 && email == "" {
+		return http.StatusBadRequest, "rest_missing_callback_param", "Missing parameter(s): email"
+	}
+	for otherID, other := range s.resources["customers"] {
+		if email != "" && otherID != id && other["email"] == email {
+			return http.StatusBadRequest, "registration-error-email-exists", "An account is already registered with your email address."
+		}
+	}
+	return 0, "", ""
+}
+
+func (s *Server) create(resource string, obj map[string]interface{}) map[string]interface{} {
+	id := s.nextID
+	s.nextID++
+	now := time.Now().UTC().Format("2006-01-02T15:04:05")
+	obj["id"] = id
+	obj["date_created"], obj["date_created_gmt"] = now, now
+	obj["date_modified"], obj["date_modified_gmt"] = now, now
+	if status := resources[resource].defaultStatus; status != "" && obj["status"] == nil {
+		obj["status"] = status
+	}
+	s.resources[resource][id] = obj
+	return obj
+}
+
+func (s *Server) update(obj, changes map[string]interface{}) map[string]interface{} {
+	for k, v := range changes {
+		if k != "id" {
+			obj[k] = v
+		}
+	}
+	now := time.Now().UTC().Format("2006-01-02T15:04:05")
+	obj["date_modified"], obj["date_modified_gmt"] = now, now
+	return obj
+}
+
+func (s *Server) delete(resource string, id int, force bool) map[string]interface{} {
+	obj := s.resources[resource][id]
+	if force {
+		delete(s.resources[resource], id)
+		return obj
+	}
+	obj["status"] = "trash"
+	return obj
+}
+
+func (s *Server) batch(w http.ResponseWriter, r *http.Request, resource string, info resourceInfo) {
+	var req struct {
+		Create []map[string]interface{} `json:"create"`
+		Update []map[string]interface{} `json:"update"`
+		Delete []int                    `json:"delete"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+		return
+	}
+	if n := len(req.Create) + len(req.Update) + len(req.Delete); n > 100 {
+		writeError(w, http.StatusRequestEntityTooLarge, "woocommerce_rest_request_entity_too_large", "Unable to accept more than 100 items for this request.")
+		return
+	}
+	resp := map[string][]interface{}{}
+	for _, obj := range req.Create {
+		if status, code, message := s.validate(resource, 0, obj); status != 0 {
+			resp["create"] = append(resp["create"], itemError(0, status, code, message))
+			continue
+		}
+		resp["create"] = append(resp["create"], s.create(resource, obj))
+	}
+	for _, changes := range req.Update {
+		id := idOf(changes)
+		obj, ok := s.resources[resource][id]
+		if !ok {
+			resp["update"] = append(resp["update"], itemError(id, http.StatusBadRequest, info.invalidID, "Invalid ID."))
+			continue
+		}
+		if status, code, message := s.validate(resource, id, changes); status != 0 {
+			resp["update"] = append(resp["update"], itemError(id, status, code, message))
+			continue
+		}
+		resp["update"] = append(resp["update"], s.update(obj, changes))
+	}
+	for _, id := range req.Delete {
+		if _, ok := s.resources[resource][id]; !ok {
+			resp["delete"] = append(resp["delete"], itemError(id, http.StatusNotFound, info.invalidID, "Invalid ID."))
+			continue
+		}
+		resp["delete"] = append(resp["delete"], s.delete(resource, id, true))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func itemError(id, status int, code, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+			"data":    map[string]int{"status": status},
+		},
+	}
+}
+
+func idOf(obj map[string]interface{}) int {
+	switch id := obj["id"].(type) {
+	case int:
+		return id
+	case float64:
+		return int(id)
+	}
+	return 0
+}
+
+func decodeObject(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	obj := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, "rest_invalid_json", "Invalid JSON body passed.")
+		return nil, false
+	}
+	return obj, true
+}
+
+func toObject(v interface{}) map[string]interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+func fromObject(obj map[string]interface{}, v interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":    code,
+		"message": message,
+		"data":    map[string]int{"status": status},
+	})
+}
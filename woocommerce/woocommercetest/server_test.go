@@ -0,0 +1,143 @@
+package woocommercetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mikespook/wc-api-golang/woocommerce"
+)
+
+func TestServerProducts(t *testing.T) {
+	for name, newServer := range map[string]func() *Server{"http": NewServer, "https": NewTLSServer} {
+		t.Run(name, func(t *testing.T) {
+			store := newServer()
+			defer store.Close()
+			client, err := store.Client(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := context.Background()
+
+			created, err := client.Products.Create(ctx, &woocommerce.Product{Name: "Hoodie", SKU: "H-1", RegularPrice: woocommerce.MustParseDecimal("45")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if created.ID == 0 || created.Status != "publish" || created.DateCreated.IsZero() {
+				t.Errorf("created = %+v", created)
+			}
+			updated, err := client.Products.Update(ctx, created.ID, &woocommerce.Product{RegularPrice: woocommerce.MustParseDecimal("40")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.Name != "Hoodie" || updated.RegularPrice.String() != "40" {
+				t.Errorf("updated = %+v", updated)
+			}
+			if _, err := client.Products.Delete(ctx, created.ID, false); err != nil {
+				t.Fatal(err)
+			}
+			if p, ok := store.Product(created.ID); !ok || p.Status != "trash" {
+				t.Errorf("trashed product = %+v", p)
+			}
+			if _, err := client.Products.Delete(ctx, created.ID, true); err != nil {
+				t.Fatal(err)
+			}
+			var apiErr *woocommerce.APIError
+			if _, err := client.Products.Get(ctx, created.ID); !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+				t.Errorf("get deleted product: err = %v", err)
+			}
+		})
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	store := NewServer()
+	defer store.Close()
+	for i := 0; i < 25; i++ {
+		store.AddOrder(woocommerce.Order{Status: "processing", Currency: "USD"})
+	}
+	store.AddOrder(woocommerce.Order{Currency: "USD"})
+	client, err := store.Client(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	orders, resp, err := client.Orders.ListByStatus(ctx, woocommerce.NewListParams().PerPage(10).Page(3), "processing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 5 || resp.Total != 25 || resp.TotalPages != 3 {
+		t.Errorf("orders = %d, total = %d, pages = %d", len(orders), resp.Total, resp.TotalPages)
+	}
+	count := 0
+	err = client.Orders.ListAll(ctx, nil, func(page []woocommerce.Order) error {
+		count += len(page)
+		return nil
+	})
+	if err != nil || count != 26 {
+		t.Errorf("ListAll saw %d orders, err = %v", count, err)
+	}
+}
+
+func TestServerCustomers(t *testing.T) {
+	store := NewServer()
+	defer store.Close()
+	store.AddCustomer(woocommerce.Customer{Email: "john.doe@example.com", FirstName: "John"})
+	client, err := store.Client(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	customer, err := client.Customers.GetByEmail(ctx, "john.doe@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.FirstName != "John" {
+		t.Errorf("customer = %+v", customer)
+	}
+	var apiErr *woocommerce.APIError
+	if _, err := client.Customers.Create(ctx, &woocommerce.Customer{Email: "john.doe@example.com"}); !errors.As(err, &apiErr) || apiErr.Code != "registration-error-email-exists" {
+		t.Errorf("duplicate email: err = %v", err)
+	}
+	if _, err := client.Customers.Delete(ctx, customer.ID, false); !errors.As(err, &apiErr) || apiErr.StatusCode != 501 {
+		t.Errorf("trash customer: err = %v", err)
+	}
+}
+
+func TestServerBatch(t *testing.T) {
+	store := NewServer()
+	defer store.Close()
+	client, err := store.Client(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &woocommerce.BatchRequest[woocommerce.Product]{}
+	for i := 0; i < 150; i++ {
+		req.Create = append(req.Create, woocommerce.Product{Name: fmt.Sprintf("Product %d", i)})
+	}
+	req.Delete = []int{9999}
+	resp, err := client.Products.Batch(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Create) != 150 || resp.Create[149].Name != "Product 149" {
+		t.Errorf("created %d products", len(resp.Create))
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Op != "delete" {
+		t.Errorf("errors = %v", resp.Errors)
+	}
+}
+
+func TestServerAuth(t *testing.T) {
+	store := NewServer()
+	defer store.Close()
+	client, err := woocommerce.NewClient(store.URL, "ck_wrong", "cs_wrong", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *woocommerce.APIError
+	if _, err := client.Products.Get(context.Background(), 1); !errors.As(err, &apiErr) || apiErr.Code != "woocommerce_rest_authentication_error" {
+		t.Errorf("err = %v", err)
+	}
+}